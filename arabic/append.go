@@ -0,0 +1,105 @@
+package arabic
+
+// fixed arrays filled from small_words, used by AppendWords to avoid
+// map lookups and string concatenation
+var (
+	ones_array     [20]SmallWord // 0 to 19, ones_array[0] is empty
+	tens_array     [10]SmallWord // tens_array[2] = "عشرون"
+	hundreds_array [10]SmallWord // hundreds_array[2] = "مئتان"
+)
+
+func init() {
+	for num, word := range small_words {
+		switch {
+		case num < 20:
+			ones_array[num] = word
+		case num < 100:
+			tens_array[num/10] = word
+		default:
+			hundreds_array[num/100] = word
+		}
+	}
+}
+
+// same as addTens with feminine=false
+func appendTens(dst []byte, tens uint16, hundreds uint16, level int) []byte {
+	if tens < 20 {
+		if tens == 2 && hundreds == 0 && level > 0 {
+			dst = append(dst, group_words[level].Genitive...)
+			return append(dst, "ن"...)
+		}
+		if tens == 1 && level > 0 {
+			return append(dst, group_words[level].Normal...)
+		}
+		return append(dst, ones_array[tens].Male...)
+	}
+	ones := tens % 10
+	if ones == 0 {
+		return append(dst, tens_array[tens/10].Male...)
+	}
+	dst = append(dst, ones_array[ones].Male...)
+	dst = append(dst, ar_and...)
+	return append(dst, tens_array[tens/10].Male...)
+}
+
+// same as addGroup with feminine=false, number != 0
+func appendGroup(dst []byte, number uint16, level int, appending bool) []byte {
+	tens := number % 100
+	hundreds := number / 100
+	switch {
+	case hundreds == 0:
+		dst = appendTens(dst, tens, 0, level)
+	case tens == 0 && hundreds == 2 && level > 0:
+		dst = append(dst, group_words[0].Genitive...)
+	case tens == 0:
+		dst = append(dst, hundreds_array[hundreds].Male...)
+	default:
+		dst = append(dst, hundreds_array[hundreds].Male...)
+		dst = append(dst, ar_and...)
+		dst = appendTens(dst, tens, hundreds*100, level)
+	}
+	if level == 0 || number == 2 || number%100 == 1 {
+		return dst
+	}
+	dst = append(dst, ' ')
+	if number >= 3 && number <= 10 {
+		return append(dst, group_words[level].Plural...)
+	}
+	if appending {
+		return append(dst, group_words[level].Appended...)
+	}
+	return append(dst, group_words[level].Normal...)
+}
+
+// AppendWords appends words of n to dst and returns the extended buffer
+// It gives the same result as ConvertBigInt with default options, without
+// any memory allocation if dst has enough capacity
+func AppendWords(dst []byte, n uint64) []byte {
+	if n == 0 {
+		return append(dst, ar_zero...)
+	}
+	var groups [7]uint16
+	k := 0
+	lowest := -1 // index of lowest non-zero group
+	for n > 0 {
+		groups[k] = uint16(n % 1000)
+		if lowest < 0 && groups[k] != 0 {
+			lowest = k
+		}
+		n /= 1000
+		k++
+	}
+	first := true
+	for i := k - 1; i >= 0; i-- {
+		p := groups[i]
+		if p == 0 {
+			continue
+		}
+		if !first {
+			dst = append(dst, ar_and...)
+		}
+		first = false
+		dst = appendGroup(dst, p, i, i > lowest)
+	}
+	return dst
+}
@@ -6,19 +6,27 @@ import (
 	"math/big"
 	"strconv"
 	"strings"
-)
 
-var (
-	big_0    = big.NewInt(0)
-	big_1    = big.NewInt(1)
-	big_1000 = big.NewInt(1000)
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/groups"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
 )
 
+var big_0 = big.NewInt(0)
+
 const (
-	ar_and  = " و "
-	ar_zero = "صفر"
+	ar_and      = " و "
+	ar_negative = "سالب"
+	ar_zero     = "صفر"
 )
 
+var defaultOptions = num2words.Options{
+	GroupSeparator: ar_and,
+	Conjunction:    ar_and,
+	DigitSeparator: "، ",
+}
+
 type SmallWord struct {
 	Male   string
 	Female string
@@ -229,39 +237,145 @@ var group_words = []GroupWord{
 	},
 }
 
-func ConvertString(number string) (string, error) {
-	if number == "0" {
-		return ar_zero, nil
+func newOptions(opts []num2words.Option) *num2words.Options {
+	return num2words.NewOptions(defaultOptions, opts)
+}
+
+// maximum number of digits supported by group_words
+var maxDigits = 3 * len(group_words)
+
+func addSign(l *tokens.List) {
+	l.Add(num2words.TokenSign, ar_negative, -1)
+	l.Space(" ")
+}
+
+func stringTokens(number string, o *num2words.Options) (*tokens.List, error) {
+	n, err := numstr.Parse(number, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	number = n.Digits
+	if digits := len(strings.TrimLeft(number, "0")); digits > maxDigits {
+		return nil, &num2words.TooLargeError{Digits: digits, MaxDigits: maxDigits}
 	}
 	groups, err := extractGroupsByString(number)
+	if err != nil {
+		return nil, err
+	}
+	l := &tokens.List{}
+	if n.Negative && number != "0" {
+		addSign(l)
+	}
+	addGroups(l, groups, o)
+	return l, nil
+}
+
+// ConvertStringTokens is like ConvertString, but returns tokens
+func ConvertStringTokens(number string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	l, err := stringTokens(number, o)
+	if err != nil {
+		return nil, err
+	}
+	return l.Result(o), nil
+}
+
+// ConvertString: number may have a sign and thousands separators, unless
+// strict option is enabled
+func ConvertString(number string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertStringTokens(number, opts...)
 	if err != nil {
 		return "", err
 	}
-	result := []string{}
-	for _, group := range groups {
-		groupResult := convertGroup(group, false, len(result) > 0)
-		if groupResult == "" {
-			continue
+	return num2words.JoinTokens(result), nil
+}
+
+// addBigInt adds words of absolute value of number
+func addBigInt(l *tokens.List, number *big.Int, o *num2words.Options) error {
+	groups := extractGroupsByBigInt(number)
+	if len(groups) > len(group_words) {
+		return &num2words.TooLargeError{
+			Digits:    len(new(big.Int).Abs(number).String()),
+			MaxDigits: maxDigits,
 		}
-		result = append([]string{groupResult}, result...)
 	}
-	return strings.Join(result, ar_and), nil
+	addGroups(l, groups, o)
+	return nil
 }
 
-func ConvertBigInt(number *big.Int) string {
-	if number.Cmp(big_0) == 0 {
-		return ar_zero
+func bigIntTokens(number *big.Int, signed bool, o *num2words.Options) ([]num2words.Token, error) {
+	l := &tokens.List{}
+	if signed && number.Cmp(big_0) < 0 {
+		addSign(l)
+	}
+	err := addBigInt(l, number, o)
+	if err != nil {
+		return nil, err
 	}
-	groups := extractGroupsByBigInt(number.Bytes())
-	result := []string{}
-	for _, group := range groups {
-		groupResult := convertGroup(group, false, len(result) > 0)
-		if groupResult == "" {
+	return l.Result(o), nil
+}
+
+// ConvertBigIntTokens is like ConvertBigIntSigned, but returns tokens
+func ConvertBigIntTokens(number *big.Int, opts ...num2words.Option) ([]num2words.Token, error) {
+	return bigIntTokens(number, true, newOptions(opts))
+}
+
+// ConvertBigInt: only for non-negative integers
+// Panics with *num2words.TooLargeError if number has more than 24 digits
+func ConvertBigInt(number *big.Int, opts ...num2words.Option) string {
+	result, err := bigIntTokens(number, false, newOptions(opts))
+	if err != nil {
+		panic(err)
+	}
+	return num2words.JoinTokens(result)
+}
+
+// ConvertBigIntSigned panics with *num2words.TooLargeError if number
+// has more than 24 digits
+func ConvertBigIntSigned(number *big.Int, opts ...num2words.Option) string {
+	result, err := ConvertBigIntTokens(number, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return num2words.JoinTokens(result)
+}
+
+// Convert: for any native integer type, signed or unsigned
+func Convert[T num2words.Integer](n T, opts ...num2words.Option) string {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	abs, negative := groups.Abs(n)
+	if negative {
+		addSign(l)
+	}
+	addGroups(l, groupsFromUint64(abs), o)
+	return num2words.JoinTokens(l.Result(o))
+}
+
+// addGroups adds words of groups, most significant first
+func addGroups(l *tokens.List, groups []Group, o *num2words.Options) {
+	lowest := -1 // index of lowest non-zero group
+	for i, group := range groups {
+		if group.number != 0 {
+			lowest = i
+			break
+		}
+	}
+	if lowest < 0 {
+		l.Add(num2words.TokenDigit, ar_zero, 0)
+		return
+	}
+	start := l.Len()
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		if group.number == 0 {
 			continue
 		}
-		result = append([]string{groupResult}, result...)
+		if l.Len() > start {
+			l.Conjunction(o.GroupSeparator, i)
+		}
+		addGroup(l, group, false, i > lowest, o)
 	}
-	return strings.Join(result, ar_and)
 }
 
 type Group struct {
@@ -269,45 +383,45 @@ type Group struct {
 	number uint16
 }
 
-// groupNumber < 1000
-func convertGroup(group Group, feminine bool, appending bool) string {
+// group.number < 1000, not zero
+func addGroup(l *tokens.List, group Group, feminine bool, appending bool, o *num2words.Options) {
 	// convert group into its text
-	groupDescription := processGroup(group, feminine)
-	if groupDescription == "" {
-		return ""
-	}
-	if group.level == 0 {
-		return groupDescription
+	addGroupNumber(l, group, feminine, o)
+	if group.level == 0 || group.number == 2 || group.number%100 == 1 {
+		return
 	}
-	if group.number != 2 && group.number%100 != 1 {
-		if group.number >= 3 && group.number <= 10 {
-			// for numbers between 3 and 9 we use plural name
-			return groupDescription + " " + group_words[group.level].Plural
-		}
-		if appending {
-			// use appending case
-			return groupDescription + " " + group_words[group.level].Appended
-		}
+	level := int(group.level)
+	l.Space(" ")
+	switch {
+	case group.number >= 3 && group.number <= 10:
+		// for numbers between 3 and 9 we use plural name
+		l.Add(num2words.TokenScale, group_words[level].Plural, level)
+	case appending:
+		// use appending case
+		l.Add(num2words.TokenScale, group_words[level].Appended, level)
+	default:
 		// use normal case
-		return groupDescription + " " + group_words[group.level].Normal
-
+		l.Add(num2words.TokenScale, group_words[level].Normal, level)
 	}
-	return groupDescription
 }
 
-func extractGroupsByBigInt(numberBytes []byte) []Group {
-	number := &big.Int{}
-	number.SetBytes(numberBytes)
-	groups := []Group{}
-	for number.Cmp(big_1) >= 0 {
-		groupNumberBig := &big.Int{}
-		number.DivMod(number, big_1000, groupNumberBig)
-		groups = append(groups, Group{
-			number: uint16(groupNumberBig.Int64()),
-			level:  uint64(len(groups)),
-		})
+func extractGroupsByBigInt(number *big.Int) []Group {
+	return makeGroups(groups.FromBigInt(number))
+}
+
+func groupsFromUint64(number uint64) []Group {
+	return makeGroups(groups.FromUint64(number))
+}
+
+func makeGroups(parts []uint16) []Group {
+	a_groups := make([]Group, len(parts))
+	for i, p := range parts {
+		a_groups[i] = Group{
+			number: p,
+			level:  uint64(i),
+		}
 	}
-	return groups
+	return a_groups
 }
 
 func extractGroupsByString(numStr string) ([]Group, error) {
@@ -345,41 +459,54 @@ func getDigitWord(digit uint16, groupLevel uint64, feminine bool) string {
 	return small_words[digit].Male
 }
 
-func processTens(tens uint16, hundreds uint16, groupLevel uint64, feminine bool) string {
+func addTens(l *tokens.List, tens uint16, hundreds uint16, groupLevel uint64, feminine bool, o *num2words.Options) {
+	level := int(groupLevel)
 	if tens < 20 {
 		// if we are processing under 20 numbers
 		if tens == 2 && hundreds == 0 && groupLevel > 0 {
 			// This is special case for number 2 when it comes alone in the group
 			// In the case of individuals
-			return group_words[groupLevel].Genitive + "ن"
+			l.Add(num2words.TokenScale, group_words[groupLevel].Genitive+"ن", level)
+			return
 		}
 		// General case
 		if tens == 1 && groupLevel > 0 {
-			return group_words[groupLevel].Normal
+			l.Add(num2words.TokenScale, group_words[groupLevel].Normal, level)
+			return
 		}
 		// Get Feminine status for this digit
-		return getDigitWord(tens, groupLevel, feminine)
+		l.Add(tokens.SmallKind(tens), getDigitWord(tens, groupLevel, feminine), level)
+		return
 	}
 	ones := tens % 10
 	if ones == 0 {
-		return small_words[tens].Male
+		l.Add(num2words.TokenTens, small_words[tens].Male, level)
+		return
 	}
-	return getDigitWord(ones, groupLevel, feminine) + ar_and + small_words[tens/10*10].Male
+	l.Add(num2words.TokenDigit, getDigitWord(ones, groupLevel, feminine), level)
+	l.Conjunction(o.Conjunction, level)
+	l.Add(num2words.TokenTens, small_words[tens/10*10].Male, level)
 }
 
-func processGroup(group Group, feminine bool) string {
+func addGroupNumber(l *tokens.List, group Group, feminine bool, o *num2words.Options) {
+	level := int(group.level)
 	tens := group.number % 100
 	hundreds := group.number / 100 * 100
 	if hundreds == 0 {
-		return processTens(tens, hundreds, group.level, feminine)
+		addTens(l, tens, hundreds, group.level, feminine, o)
+		return
 	}
 	if tens == 0 {
 		if hundreds == 200 && group.level > 0 {
 			// genitive case - حالة المضاف
-			return group_words[0].Genitive
+			l.Add(num2words.TokenHundred, group_words[0].Genitive, level)
+			return
 		}
-		return small_words[hundreds].Male
+		l.Add(num2words.TokenHundred, small_words[hundreds].Male, level)
+		return
 	}
 	// normal case - الحالة العادية
-	return small_words[hundreds].Male + ar_and + processTens(tens, hundreds, group.level, feminine)
+	l.Add(num2words.TokenHundred, small_words[hundreds].Male, level)
+	l.Conjunction(o.Conjunction, level)
+	addTens(l, tens, hundreds, group.level, feminine, o)
 }
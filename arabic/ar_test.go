@@ -3,12 +3,15 @@ package arabic_test
 import (
 	"bufio"
 	"compress/gzip"
+	"errors"
+	"math"
 	"math/big"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
 	"github.com/ilius/num2words/arabic"
 )
 
@@ -96,3 +99,96 @@ func TestConvertBigIntTiny(t *testing.T) {
 	is.Equal(arabic.ConvertBigInt(big.NewInt(1)), "واحد")
 	is.Equal(arabic.ConvertBigInt(big.NewInt(2)), "اثنان")
 }
+
+func TestConvertStringErrors(t *testing.T) {
+	is := is.New(t).Lax()
+	_, err := arabic.ConvertString("")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+
+	_, err = arabic.ConvertString("1234x6")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	var charErr *num2words.InvalidCharError
+	if is.True(errors.As(err, &charErr)) {
+		is.Equal(charErr.Offset, 4)
+		is.Equal(charErr.Char, 'x')
+	}
+
+	_, err = arabic.ConvertString("12")
+	is.NotErr(err)
+}
+
+func TestConvertTooLarge(t *testing.T) {
+	is := is.New(t).Lax()
+	_, err := arabic.ConvertString("1" + strings.Repeat("0", 24))
+	is.True(errors.Is(err, num2words.ErrTooLarge))
+	var tlErr *num2words.TooLargeError
+	if is.True(errors.As(err, &tlErr)) {
+		is.Equal(tlErr.Digits, 25)
+		is.Equal(tlErr.MaxDigits, 24)
+	}
+
+	_, err = arabic.ConvertString("0" + strings.Repeat("9", 24))
+	is.NotErr(err)
+
+	bn := &big.Int{}
+	bn.SetString("1"+strings.Repeat("0", 24), 10)
+	is.ShouldPanic(func() {
+		_ = arabic.ConvertBigInt(bn)
+	})
+}
+
+func TestConvertStringNormalize(t *testing.T) {
+	is := is.New(t).Lax()
+	words, err := arabic.ConvertString("1,002")
+	is.NotErr(err)
+	is.Equal(words, "ألف و اثنان")
+	words, err = arabic.ConvertString("0000")
+	is.NotErr(err)
+	is.Equal(words, "صفر")
+	words, err = arabic.ConvertString("0000", num2words.WithStrict(true))
+	is.NotErr(err)
+	is.Equal(words, "صفر")
+	words, err = arabic.ConvertString("-2")
+	is.NotErr(err)
+	is.Equal(words, "سالب اثنان")
+}
+
+func TestConvertStringArabicDigits(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, words string) {
+		actual, err := arabic.ConvertString(str)
+		is.Msg("num=%#v", str).NotErr(err)
+		is.Msg("num=%#v", str).Equal(actual, words)
+	}
+	test("١٠٠٢", "ألف و اثنان")
+	test("۱۰۰۲", "ألف و اثنان")
+	test("١٬٠٠٢", "ألف و اثنان")
+	test("1٠0٢", "ألف و اثنان")
+}
+
+func TestConvertGeneric(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		if !tc.BigInt.IsInt64() {
+			continue
+		}
+		n := tc.BigInt.Int64()
+		is.Msg("num=%v", n).Equal(arabic.Convert(n), tc.Words)
+		is.Msg("num=%v", n).Equal(arabic.Convert(uint64(n)), tc.Words)
+		bn_neg := big.NewInt(-n)
+		is.Msg("num=%v", -n).Equal(arabic.Convert(-n), arabic.ConvertBigIntSigned(bn_neg))
+	}
+	testLimit := func(n string, words string) {
+		bn := &big.Int{}
+		bn.SetString(n, 10)
+		is.Msg("num=%v", n).Equal(words, arabic.ConvertBigIntSigned(bn))
+	}
+	testLimit("-9223372036854775808", arabic.Convert(int64(math.MinInt64)))
+	testLimit("9223372036854775807", arabic.Convert(int64(math.MaxInt64)))
+	testLimit("18446744073709551615", arabic.Convert(uint64(math.MaxUint64)))
+	testLimit("-128", arabic.Convert(int8(math.MinInt8)))
+	testLimit("255", arabic.Convert(uint8(255)))
+	testLimit("-2147483648", arabic.Convert(int32(math.MinInt32)))
+	testLimit("0", arabic.Convert(0))
+	testLimit("-7", arabic.Convert(-7))
+}
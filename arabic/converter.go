@@ -0,0 +1,55 @@
+package arabic
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+)
+
+func init() {
+	num2words.Register("ar", converter{})
+}
+
+// converter implements num2words.Converter
+type converter struct{}
+
+func (converter) Tag() string {
+	return "ar"
+}
+
+func (converter) Capabilities() num2words.Capability {
+	return num2words.Cardinal | num2words.Signed
+}
+
+func (converter) ConvertString(str string, opts ...num2words.Option) (string, error) {
+	return ConvertString(str, opts...)
+}
+
+func (converter) ConvertBigInt(bn *big.Int, opts ...num2words.Option) (string, error) {
+	if bn.Sign() < 0 {
+		return "", numstr.BigIntSignError(bn)
+	}
+	result, err := bigIntTokens(bn, false, newOptions(opts))
+	if err != nil {
+		return "", err
+	}
+	return num2words.JoinTokens(result), nil
+}
+
+func (converter) ConvertBigIntSigned(bn *big.Int, opts ...num2words.Option) (string, error) {
+	result, err := ConvertBigIntTokens(bn, opts...)
+	if err != nil {
+		return "", err
+	}
+	return num2words.JoinTokens(result), nil
+}
+
+func (converter) ConvertOrdinalString(string, ...num2words.Option) (string, error) {
+	return "", fmt.Errorf("arabic: ordinal: %w", num2words.ErrUnsupported)
+}
+
+func (converter) ConvertOrdinalBigInt(*big.Int, ...num2words.Option) (string, error) {
+	return "", fmt.Errorf("arabic: ordinal: %w", num2words.ErrUnsupported)
+}
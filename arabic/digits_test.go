@@ -0,0 +1,29 @@
+package arabic_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/arabic"
+)
+
+func TestConvertDigits(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := arabic.ConvertDigits(str, opts...)
+		is.NotErr(err)
+		is.Msg("str=%#v", str).Equal(words, expected)
+	}
+	test("415-01", "أربعة واحد خمسة، صفر واحد")
+	test("12345", "واحد اثنان ثلاثة، أربعة خمسة", num2words.WithDigitGroups(3))
+	test("۰۰۷", "صفر صفر سبعة")
+	// not used by this language
+	test("007", "صفر صفر سبعة", num2words.WithOh(true))
+
+	_, err := arabic.ConvertDigits("12a")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	_, err = arabic.ConvertDigits("007", num2words.WithDigitRepeat(true))
+	is.True(errors.Is(err, num2words.ErrUnsupported))
+}
@@ -0,0 +1,50 @@
+package arabic
+
+import (
+	"io"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/stream"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+// ConvertReader reads digits of a non-negative integer from r and writes
+// words to w, group by group, most significant first.
+// White space between digits is ignored. Memory usage does not depend on
+// the number of digits, if r can not seek, it is copied to a temporary file.
+func ConvertReader(w io.Writer, r io.Reader, opts ...num2words.Option) error {
+	o := newOptions(opts)
+	sw := stream.NewWriter(w, o)
+	lowest := 0
+	check := func(info *stream.Info) error {
+		if info.Digits > maxDigits {
+			return &num2words.TooLargeError{Digits: info.Digits, MaxDigits: maxDigits}
+		}
+		if info.Digits == 0 {
+			return sw.WriteString(ar_zero)
+		}
+		lowest = info.Lowest
+		return nil
+	}
+	err := stream.Groups(r, check, func(i int, p uint16) error {
+		if p == 0 {
+			return nil
+		}
+		if sw.Started() {
+			if err := sw.WriteString(o.GroupSeparator); err != nil {
+				return err
+			}
+		}
+		group := Group{
+			number: p,
+			level:  uint64(i),
+		}
+		l := &tokens.List{}
+		addGroup(l, group, false, i > lowest, o)
+		return sw.WriteString(l.String())
+	})
+	if err != nil {
+		return err
+	}
+	return sw.Flush()
+}
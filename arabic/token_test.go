@@ -0,0 +1,47 @@
+package arabic_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/arabic"
+)
+
+func TestConvertStringTokens(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		tokens, err := arabic.ConvertStringTokens(tc.String)
+		is.NotErr(err)
+		is.Msg("num=%v", tc.String).Equal(num2words.JoinTokens(tokens), tc.Words)
+	}
+}
+
+func TestConvertBigIntTokens(t *testing.T) {
+	is := is.New(t)
+	tokens, err := arabic.ConvertBigIntTokens(big.NewInt(2_005_021))
+	is.NotErr(err)
+	kinds := []num2words.TokenKind{}
+	groups := []int{}
+	for _, t := range tokens {
+		kinds = append(kinds, t.Kind)
+		groups = append(groups, t.Group)
+	}
+	is.Equal(kinds, []num2words.TokenKind{
+		num2words.TokenScale,
+		num2words.TokenConjunction,
+		num2words.TokenDigit,
+		num2words.TokenScale,
+		num2words.TokenConjunction,
+		num2words.TokenDigit,
+		num2words.TokenConjunction,
+		num2words.TokenTens,
+	})
+	is.Equal(groups, []int{2, 1, 1, 1, 0, 0, 0, 0})
+	is.Equal(num2words.JoinTokens(tokens), "مليونان و خمسة آلاف و واحد و عشرون")
+
+	_, err = arabic.ConvertBigIntTokens(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil))
+	is.True(errors.Is(err, num2words.ErrTooLarge))
+}
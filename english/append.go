@@ -0,0 +1,87 @@
+package english
+
+// fixed arrays filled from small_words, used by AppendWords to avoid
+// map lookups
+var (
+	ones_array [20]string // 0 to 19
+	tens_array [10]string // tens_array[2] = "Twenty"
+)
+
+func init() {
+	for num, word := range small_words {
+		if num < 20 {
+			ones_array[num] = word
+			continue
+		}
+		tens_array[num/10] = word
+	}
+}
+
+// num < 1000, same as addSmall with default options
+func appendSmall(dst []byte, num uint16) []byte {
+	if num < 20 {
+		return append(dst, ones_array[num]...)
+	}
+	ones := num % 10
+	tens := (num % 100) / 10
+	hundreds := num / 100
+	if hundreds != 0 {
+		dst = append(dst, ones_array[hundreds]...)
+		dst = append(dst, ' ')
+		dst = append(dst, en_hundred...)
+		if tens == 0 && ones == 0 {
+			return dst
+		}
+		dst = append(dst, ' ')
+	}
+	if tens == 0 {
+		return append(dst, ones_array[ones]...)
+	}
+	if tens == 1 {
+		return append(dst, ones_array[10+ones]...)
+	}
+	dst = append(dst, tens_array[tens]...)
+	if ones != 0 {
+		dst = append(dst, ' ')
+		dst = append(dst, ones_array[ones]...)
+	}
+	return dst
+}
+
+// appendOrder appends the scale word of group index 0 < i < len(big_words)
+func appendOrder(dst []byte, i int) []byte {
+	return append(dst, big_words[i]...)
+}
+
+// AppendWords appends words of n to dst and returns the extended buffer
+// It gives the same result as ConvertBigInt with default options, without
+// any memory allocation if dst has enough capacity
+func AppendWords(dst []byte, n uint64) []byte {
+	if n < 1000 {
+		return appendSmall(dst, uint16(n))
+	}
+	var groups [7]uint16
+	k := 0
+	for n > 0 {
+		groups[k] = uint16(n % 1000)
+		n /= 1000
+		k++
+	}
+	first := true
+	for i := k - 1; i >= 0; i-- {
+		p := groups[i]
+		if p == 0 {
+			continue
+		}
+		if !first {
+			dst = append(dst, en_and...)
+		}
+		first = false
+		dst = appendSmall(dst, p)
+		if i > 0 {
+			dst = append(dst, ' ')
+			dst = appendOrder(dst, i)
+		}
+	}
+	return dst
+}
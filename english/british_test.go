@@ -0,0 +1,61 @@
+package english_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertStringBritishAnd(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		opts = append(opts, num2words.WithBritishAnd(true))
+		words, err := english.ConvertString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(words, expected)
+		buf := bytes.NewBuffer(nil)
+		err = english.ConvertReader(buf, strings.NewReader(str), opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(buf.String(), expected)
+	}
+	test("5", "Five")
+	test("100", "One Hundred")
+	test("105", "One Hundred and Five")
+	test("999", "Nine Hundred and Ninety Nine")
+	test("1000", "One Thousand")
+	test("1005", "One Thousand and Five")
+	test("1020", "One Thousand and Twenty")
+	test("1100", "One Thousand, One Hundred")
+	test("1105", "One Thousand, One Hundred and Five")
+	test("2000099", "Two Million and Ninety Nine")
+	test("2001099", "Two Million, One Thousand and Ninety Nine")
+	test("101001", "One Hundred and One Thousand and One")
+	test("1000000", "One Million")
+	test("105", "one hundred and five", num2words.WithCase(num2words.CaseLower))
+	test("1005", "One thousand and five", num2words.WithCase(num2words.CaseSentence))
+	test("1005", "One Thousand And Five", num2words.WithCase(num2words.CaseTitle))
+	test("1105", "One Thousand One Hundred and Five", num2words.WithGroupSeparator(" "))
+
+	words, err := english.ConvertString("1005")
+	is.NotErr(err)
+	is.Equal(words, "One Thousand, Five")
+}
+
+func TestConvertBritishAndTokens(t *testing.T) {
+	is := is.New(t)
+	tokens, err := english.ConvertStringTokens("-1105", num2words.WithBritishAnd(true))
+	is.NotErr(err)
+	is.Equal(num2words.JoinTokens(tokens), "Negative One Thousand, One Hundred and Five")
+	last := tokens[len(tokens)-2]
+	is.Equal(last.Kind, num2words.TokenConjunction)
+	is.Equal(last.Text, "and")
+	is.Equal(last.Sep, " ")
+	is.Equal(
+		english.Convert(-7005, num2words.WithBritishAnd(true)),
+		"Negative Seven Thousand and Five",
+	)
+}
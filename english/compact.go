@@ -0,0 +1,172 @@
+package english
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+const (
+	en_about  = "About"
+	en_over   = "Over"
+	en_nearly = "Nearly"
+
+	// used before "Half" and "Quarter" in compact words
+	en_and_a = " and a "
+)
+
+// roundSignificant rounds non-negative n to the given number of significant
+// digits, if digits > 0
+func roundSignificant(n *big.Int, digits int, mode num2words.Rounding) *big.Int {
+	count := len(n.String())
+	if digits <= 0 || count <= digits {
+		return n
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(count-digits)), nil)
+	q := roundScaled(new(big.Rat).SetFrac(n, scale), 0, mode)
+	return q.Mul(q, scale)
+}
+
+// compactUnit returns the exponent of the scale word used for a number
+// with the given count of digits
+// Numbers below 1000 have exponent 0 and no scale word
+func compactUnit(count int, o *num2words.Options) int {
+	e := count - 1
+	switch {
+	case e < 3:
+		return 0
+	case isIndian(o):
+		// Thousand, Lakh, Crore, ...
+		return 3 + 2*min(indianTop(o), (e-3)/2)
+	case o.Scale == num2words.ScaleLong && e >= 6:
+		// "1500 Million" instead of "1.5 Thousand Million"
+		return e - e%6
+	}
+	return e - e%3
+}
+
+// addUnit adds the scale word of 10^e, for e > 0 returned by compactUnit
+func addUnit(l *tokens.List, e int, o *num2words.Options) {
+	if isIndian(o) {
+		k := (e - 3) / 2
+		l.Add(num2words.TokenScale, indian_words[k], k+1)
+		return
+	}
+	addOrder(l, e/3, o)
+}
+
+// addMantissa adds words of integer.fraction with num2words.CompactWords
+// "Twelve", "One Point Two", "Three and a Half", "Two and Three Quarters"
+func addMantissa(l *tokens.List, integer string, fraction string, o *num2words.Options) error {
+	err := addDigits(l, integer, o)
+	if err != nil {
+		return err
+	}
+	switch fraction {
+	case "":
+		return nil
+	case "5":
+		l.Conjunction(en_and_a, 0)
+		l.Add(num2words.TokenDigit, fraction_words[2][0], 0)
+		return nil
+	case "25":
+		l.Conjunction(en_and_a, 0)
+		l.Add(num2words.TokenDigit, fraction_words[4][0], 0)
+		return nil
+	case "75":
+		l.Conjunction(en_british_and, 0)
+		l.Add(num2words.TokenDigit, small_words[3], 0)
+		l.Space(" ")
+		l.Add(num2words.TokenDigit, fraction_words[4][1], 0)
+		return nil
+	}
+	l.Space(" ")
+	l.Add(num2words.TokenDecimalPoint, en_point, -1)
+	for i := range len(fraction) {
+		l.Space(" ")
+		l.Add(num2words.TokenDigit, small_words[uint16(fraction[i]-'0')], -1)
+	}
+	return nil
+}
+
+// addCompact adds compact words of bn, rounded to o.SignificantDigits
+func addCompact(l *tokens.List, bn *big.Int, o *num2words.Options) {
+	abs := new(big.Int).Abs(bn)
+	rounded := roundSignificant(abs, o.SignificantDigits, o.Rounding)
+	if bn.Sign() < 0 {
+		// "Negative About 1.2 Million"
+		addSign(l)
+	}
+	if cmp := rounded.Cmp(abs); cmp != 0 {
+		switch o.Qualifier {
+		case num2words.QualifierAbout:
+			l.Add(num2words.TokenQualifier, en_about, -1)
+			l.Space(" ")
+		case num2words.QualifierDirection:
+			word := en_over
+			if cmp > 0 {
+				word = en_nearly
+			}
+			l.Add(num2words.TokenQualifier, word, -1)
+			l.Space(" ")
+		}
+	}
+	digits := rounded.String()
+	e := compactUnit(len(digits), o)
+	integer := digits[:len(digits)-e]
+	fraction := strings.TrimRight(digits[len(digits)-e:], "0")
+	if o.Compact == num2words.CompactWords {
+		err := addMantissa(l, integer, fraction, o)
+		if err != nil {
+			panic(err)
+		}
+	} else {
+		text := integer
+		if fraction != "" {
+			text += "." + fraction
+		}
+		l.Add(num2words.TokenNumeral, text, -1)
+	}
+	if e > 0 {
+		l.Space(" ")
+		addUnit(l, e, o)
+	}
+}
+
+// ConvertCompactTokens is like ConvertCompactBigInt, but returns tokens
+func ConvertCompactTokens(bn *big.Int, opts ...num2words.Option) []num2words.Token {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	addCompact(l, bn, o)
+	return l.Result(o)
+}
+
+// ConvertCompactBigInt converts bn to a short approximate form, rounded to
+// significant digits (2 by default, see num2words.WithSignificantDigits and
+// num2words.WithRounding): 1234567 => "1.2 Million", or
+// "One Point Two Million" with num2words.CompactWords
+// With num2words.WithQualifier, rounded numbers start with "About", "Over"
+// or "Nearly" after the sign, the last two compare absolute values
+func ConvertCompactBigInt(bn *big.Int, opts ...num2words.Option) string {
+	return num2words.JoinTokens(ConvertCompactTokens(bn, opts...))
+}
+
+// ConvertCompactString is like ConvertCompactBigInt for an integer string,
+// parsed like ConvertString
+func ConvertCompactString(str string, opts ...num2words.Option) (string, error) {
+	o := newOptions(opts)
+	n, err := numstr.Parse(str, o.Strict)
+	if err != nil {
+		return "", err
+	}
+	bn, _ := new(big.Int).SetString(n.Digits, 10)
+	if n.Negative {
+		bn.Neg(bn)
+	}
+	l := &tokens.List{}
+	addCompact(l, bn, o)
+	return num2words.JoinTokens(l.Result(o)), nil
+}
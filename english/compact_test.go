@@ -0,0 +1,78 @@
+package english_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertCompact(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertCompactString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(words, expected)
+	}
+	words := num2words.WithCompactStyle(num2words.CompactWords)
+	lower := num2words.WithCase(num2words.CaseLower)
+	about := num2words.WithQualifier(num2words.QualifierAbout)
+	direction := num2words.WithQualifier(num2words.QualifierDirection)
+
+	test("0", "0")
+	test("0", "Zero", words)
+	test("7", "7")
+	test("123", "120")
+	test("12,345", "12 Thousand")
+	test("12,345", "Twelve Thousand", words)
+	test("1,234,567", "1.2 Million")
+	test("1,234,567", "1.2 million", lower)
+	test("1,234,567", "One Point Two Million", words)
+	test("-1,234,567", "Negative 1.2 Million")
+	test("999,999", "1 Million")
+	test("1,000,000", "1 Million", about)
+	test("3,456,789,012", "about three and a half billion", words, about, lower)
+	test("3,456,789,012", "3.46 Billion", num2words.WithSignificantDigits(3))
+	test("3,250,000,000", "Three and a Quarter Billion", words, num2words.WithSignificantDigits(3))
+	test("2,749,000", "Two and Three Quarters Million", words, num2words.WithSignificantDigits(3))
+	test("1,234,567", "1.234567 Million", num2words.WithSignificantDigits(0))
+	test("1,234,567", "1.234567 Million", num2words.WithSignificantDigits(-1))
+
+	test("1,234,567", "Over 1.2 Million", direction)
+	test("1,284,567", "Nearly 1.3 Million", direction)
+	test("1,284,567", "Over 1.2 Million", direction, num2words.WithRounding(num2words.RoundDown))
+	test("1,214,567", "Nearly 1.3 Million", direction, num2words.WithRounding(num2words.RoundUp))
+	test("1,250,000", "About 1.2 Million", about, num2words.WithRounding(num2words.RoundHalfEven))
+	test("1,250,000", "About 1.3 Million", about)
+	test("-1,234,567", "Negative About 1.2 Million", about)
+	test("-1,284,567", "Negative Nearly 1.3 Million", direction)
+
+	long := num2words.WithScale(num2words.ScaleLong)
+	test("1,500,000,000", "1500 Million", long)
+	test("1,500,000,000", "1.5 Milliard", num2words.WithScale(num2words.ScalePeletier))
+	test("2,500,000,000,000", "2.5 Billion", long)
+	test("45,000", "45 Thousand", long)
+
+	indian := num2words.WithScale(num2words.ScaleIndian)
+	test("1,50,00,000", "1.5 Crore", indian)
+	test("12,34,567", "12 Lakh", indian)
+	test("12,34,567", "Twelve Lakh", indian, words)
+	test("45,000", "45 Thousand", indian)
+	test("5,00,00,00,000", "500 Crore", indian)
+	test("5,00,00,00,000", "5 Arab", num2words.WithScale(num2words.ScaleIndianArab))
+}
+
+func TestConvertCompactBigInt(t *testing.T) {
+	is := is.New(t)
+	bn, _ := new(big.Int).SetString("7"+"123"+"000000000000000000000000000000", 10)
+	is.Equal(english.ConvertCompactBigInt(bn), "7.1 Decillion")
+	toks := english.ConvertCompactTokens(big.NewInt(12345), num2words.WithQualifier(num2words.QualifierAbout))
+	is.Equal(len(toks), 3)
+	is.Equal(toks[0].Kind, num2words.TokenQualifier)
+	is.Equal(toks[1].Kind, num2words.TokenNumeral)
+	is.Equal(toks[2].Kind, num2words.TokenScale)
+	_, err := english.ConvertCompactString("12x")
+	is.Err(err)
+}
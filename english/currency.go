@@ -0,0 +1,129 @@
+package english
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+const en_only = "Only"
+
+// Currency describes units of money
+type Currency struct {
+	Major       string // "Dollar"
+	MajorPlural string // "Dollars"
+	Minor       string // "Cent", empty if MinorDigits is 0
+	MinorPlural string // "Cents"
+
+	// MinorDigits is the number of digits of minor unit, 2 for cents
+	MinorDigits int
+}
+
+var (
+	USD = Currency{
+		Major:       "Dollar",
+		MajorPlural: "Dollars",
+		Minor:       "Cent",
+		MinorPlural: "Cents",
+		MinorDigits: 2,
+	}
+	EUR = Currency{
+		Major:       "Euro",
+		MajorPlural: "Euros",
+		Minor:       "Cent",
+		MinorPlural: "Cents",
+		MinorDigits: 2,
+	}
+	GBP = Currency{
+		Major:       "Pound",
+		MajorPlural: "Pounds",
+		Minor:       "Penny",
+		MinorPlural: "Pence",
+		MinorDigits: 2,
+	}
+	JPY = Currency{
+		Major:       "Yen",
+		MajorPlural: "Yen",
+		MinorDigits: 0,
+	}
+)
+
+// unit returns singular or plural name
+func unit(singular string, plural string, n *big.Int) string {
+	if n.Cmp(big_one) == 0 {
+		return singular
+	}
+	return plural
+}
+
+// addAmount adds words of amount r, rounded to minor unit
+func addAmount(l *tokens.List, r *big.Rat, c *Currency, o *num2words.Options) {
+	scaled := roundScaled(r, c.MinorDigits, o.Rounding)
+	if scaled.Sign() < 0 {
+		addSign(l)
+	}
+	majorStr, minorStr := splitScaled(scaled, c.MinorDigits)
+	major, _ := new(big.Int).SetString(majorStr, 10)
+	minor := big.NewInt(0)
+	if minorStr != "" {
+		minor.SetString(minorStr, 10)
+	}
+	if o.Cheque || minor.Sign() == 0 || major.Sign() != 0 {
+		addBigInt(l, major, o)
+		l.Space(" ")
+		l.Add(num2words.TokenUnit, unit(c.Major, c.MajorPlural, major), -1)
+	}
+	switch {
+	case c.MinorDigits == 0:
+	case o.Cheque:
+		// "and 56/100"
+		l.Conjunction(en_british_and, -1)
+		l.Add(num2words.TokenNumeral, minorStr+"/1"+strings.Repeat("0", c.MinorDigits), -1)
+	case minor.Sign() != 0:
+		if major.Sign() != 0 {
+			l.Conjunction(en_british_and, -1)
+		}
+		addBigInt(l, minor, o)
+		l.Space(" ")
+		l.Add(num2words.TokenUnit, unit(c.Minor, c.MinorPlural, minor), -1)
+	}
+	if o.Only {
+		l.Space(" ")
+		l.Add(num2words.TokenQualifier, en_only, -1)
+	}
+}
+
+// ConvertAmountTokens is like ConvertAmountBigRat, but returns tokens
+func ConvertAmountTokens(r *big.Rat, c Currency, opts ...num2words.Option) []num2words.Token {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	addAmount(l, r, &c, o)
+	return l.Result(o)
+}
+
+// ConvertAmountBigRat converts amount of money r, rounded to minor unit of c
+// (see num2words.WithRounding):
+// "One Thousand Dollars and Fifty Six Cents", or with num2words.WithCheque
+// "One Thousand Dollars and 56/100"
+func ConvertAmountBigRat(r *big.Rat, c Currency, opts ...num2words.Option) string {
+	return num2words.JoinTokens(ConvertAmountTokens(r, c, opts...))
+}
+
+// ConvertAmountString is like ConvertAmountBigRat for a decimal string
+// like "1,234.56", parsed like ConvertDecimalString
+func ConvertAmountString(str string, c Currency, opts ...num2words.Option) (string, error) {
+	o := newOptions(opts)
+	d, err := parseDecimal(str, o.Strict)
+	if err != nil {
+		return "", err
+	}
+	r, _ := new(big.Rat).SetString(d.integer + "." + d.fraction)
+	if d.negative {
+		r.Neg(r)
+	}
+	l := &tokens.List{}
+	addAmount(l, r, &c, o)
+	return num2words.JoinTokens(l.Result(o)), nil
+}
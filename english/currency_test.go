@@ -0,0 +1,126 @@
+package english_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertAmountString(t *testing.T) {
+	is := is.New(t).Lax()
+	cheque := num2words.WithCheque(true)
+	only := num2words.WithOnly(true)
+	test := func(str string, c english.Currency, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertAmountString(str, c, opts...)
+		is.NotErr(err)
+		is.Msg("amount=%v", str).Equal(words, expected)
+	}
+	test("1234.56", english.USD, "One Thousand Two Hundred Thirty-Four Dollars and 56/100",
+		cheque,
+		num2words.WithGroupSeparator(" "),
+		num2words.WithHyphenation(true),
+	)
+	test("1234.56", english.USD, "One Thousand Two Hundred Thirty-Four Dollars and Fifty-Six Cents",
+		num2words.WithGroupSeparator(" "),
+		num2words.WithHyphenation(true),
+	)
+	test("1", english.USD, "One Dollar")
+	test("1.01", english.USD, "One Dollar and One Cent")
+	test("0.56", english.USD, "Fifty Six Cents")
+	test("0.56", english.USD, "Zero Dollars and 56/100", cheque)
+	test("0", english.USD, "Zero Dollars")
+	test("100", english.USD, "One Hundred Dollars and 00/100", cheque)
+	test("100", english.USD, "One Hundred Dollars and 00/100 Only", cheque, only)
+	test("100", english.USD, "One Hundred Dollars Only", only)
+	test("-5.5", english.USD, "Negative Five Dollars and Fifty Cents")
+	test("2.01", english.GBP, "Two Pounds and One Penny")
+	test("2.02", english.GBP, "Two Pounds and Two Pence")
+	test("1500", english.JPY, "One Thousand, Five Hundred Yen")
+	test("1500.6", english.JPY, "One Thousand, Five Hundred One Yen")
+	test("1,000.5", english.EUR, "one thousand euros and fifty cents",
+		num2words.WithCase(num2words.CaseLower),
+		num2words.WithGroupSeparator(" "),
+	)
+
+	// rounding
+	test("2.345", english.USD, "Two Dollars and 35/100", cheque)
+	test("2.345", english.USD, "Two Dollars and 34/100", cheque,
+		num2words.WithRounding(num2words.RoundHalfEven))
+	test("2.355", english.USD, "Two Dollars and 36/100", cheque,
+		num2words.WithRounding(num2words.RoundHalfEven))
+	test("2.349", english.USD, "Two Dollars and 34/100", cheque,
+		num2words.WithRounding(num2words.RoundDown))
+	test("2.341", english.USD, "Two Dollars and 35/100", cheque,
+		num2words.WithRounding(num2words.RoundUp))
+	test("0.999", english.USD, "One Dollar")
+	test("-0.001", english.USD, "Zero Dollars")
+	test("-0.005", english.USD, "Negative One Cent")
+
+	_, err := english.ConvertAmountString("12.3x", english.USD)
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	_, err = english.ConvertAmountString("1,50", english.USD)
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+}
+
+func TestConvertAmountBigRat(t *testing.T) {
+	is := is.New(t)
+	c := english.Currency{
+		Major:       "Dinar",
+		MajorPlural: "Dinars",
+		Minor:       "Fils",
+		MinorPlural: "Fils",
+		MinorDigits: 3,
+	}
+	is.Equal(
+		english.ConvertAmountBigRat(big.NewRat(12345, 1000), c),
+		"Twelve Dinars and Three Hundred Forty Five Fils",
+	)
+	is.Equal(
+		english.ConvertAmountBigRat(big.NewRat(12345, 1000), c, num2words.WithCheque(true)),
+		"Twelve Dinars and 345/1000",
+	)
+	is.Equal(
+		english.ConvertAmountBigRat(big.NewRat(1, 3), english.USD),
+		"Thirty Three Cents",
+	)
+
+	tokens := english.ConvertAmountTokens(big.NewRat(101, 100), english.USD, num2words.WithOnly(true))
+	kinds := []num2words.TokenKind{}
+	for _, t := range tokens {
+		kinds = append(kinds, t.Kind)
+	}
+	is.Equal(kinds, []num2words.TokenKind{
+		num2words.TokenDigit,
+		num2words.TokenUnit,
+		num2words.TokenConjunction,
+		num2words.TokenDigit,
+		num2words.TokenUnit,
+		num2words.TokenQualifier,
+	})
+}
+
+func TestConvertDecimalRounding(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, mode num2words.Rounding, expected string) {
+		words, err := english.ConvertDecimalString(str,
+			num2words.WithPrecision(1),
+			num2words.WithRounding(mode),
+		)
+		is.NotErr(err)
+		is.Msg("num=%v, mode=%v", str, mode).Equal(words, expected)
+	}
+	test("2.25", num2words.RoundHalfUp, "Two Point Three")
+	test("2.25", num2words.RoundHalfEven, "Two Point Two")
+	test("2.35", num2words.RoundHalfEven, "Two Point Four")
+	test("2.29", num2words.RoundDown, "Two Point Two")
+	test("2.21", num2words.RoundUp, "Two Point Three")
+	test("-2.21", num2words.RoundUp, "Negative Two Point Three")
+	is.Equal(
+		english.ConvertBigFloat(big.NewFloat(2.675), num2words.WithPrecision(2)),
+		"Two Point Six Eight",
+	)
+}
@@ -0,0 +1,299 @@
+package english
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+const (
+	en_point = "Point"
+
+	// number of digits after decimal point for big.Rat values that have
+	// no finite decimal form, like 1/3, when precision is negative
+	maxRatDigits = 10
+)
+
+// denominator words of 10^k are made of ten_words[k%3] and scale word of
+// k/3: "Tenth", "Hundredth", "Ten Thousandth", "Hundred Thousandth"
+var ten_words = []string{"", small_words[10], en_hundred}
+
+// decimal is a parsed decimal number, with ASCII digits
+type decimal struct {
+	negative bool
+	integer  string // without leading zeros, "0" for zero
+	fraction string // digits after decimal point, may be empty
+}
+
+// isDecimalPoint returns true for "." and Arabic decimal separator
+func isDecimalPoint(c rune) bool {
+	return c == '.' || c == '٫'
+}
+
+// parseDecimal parses str, the integer part is parsed like ConvertString
+// and the fractional part must only have digits
+func parseDecimal(str string, strict bool) (*decimal, error) {
+	pointOffset := strings.IndexFunc(str, isDecimalPoint)
+	if pointOffset < 0 {
+		n, err := numstr.Parse(str, strict)
+		if err != nil {
+			return nil, err
+		}
+		return &decimal{
+			negative: n.Negative,
+			integer:  trimZeros(n.Digits),
+		}, nil
+	}
+	point, size := utf8.DecodeRuneInString(str[pointOffset:])
+	intPart := str[:pointOffset]
+	fracPart := str[pointOffset+size:]
+	if !strict {
+		fracPart = strings.TrimRightFunc(fracPart, unicode.IsSpace)
+	}
+	switch strings.TrimSpace(intPart) {
+	case "":
+		if fracPart == "" {
+			return nil, &num2words.InvalidCharError{Input: str, Offset: pointOffset, Char: point}
+		}
+		// ".5"
+		intPart += "0"
+	case "+", "-", "−":
+		intPart += "0"
+	}
+	n, err := numstr.Parse(intPart, strict)
+	if err != nil {
+		return nil, err
+	}
+	var fraction strings.Builder
+	fraction.Grow(len(fracPart))
+	for i, c := range fracPart {
+		d, ok := numstr.DigitValue(c)
+		if !ok {
+			return nil, &num2words.InvalidCharError{
+				Input:  str,
+				Offset: pointOffset + size + i,
+				Char:   c,
+			}
+		}
+		fraction.WriteByte('0' + d)
+	}
+	return &decimal{
+		negative: n.Negative,
+		integer:  trimZeros(n.Digits),
+		fraction: fraction.String(),
+	}, nil
+}
+
+// trimZeros removes leading zeros of digits, and returns "0" if all
+// digits are zero
+func trimZeros(digits string) string {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return "0"
+	}
+	return digits
+}
+
+// round rounds or pads fractional digits to precision
+func (d *decimal) round(precision int, mode num2words.Rounding) {
+	if precision < 0 || len(d.fraction) == precision {
+		return
+	}
+	if len(d.fraction) < precision {
+		d.fraction += strings.Repeat("0", precision-len(d.fraction))
+		return
+	}
+	r, _ := new(big.Rat).SetString(d.integer + "." + d.fraction)
+	d.integer, d.fraction = splitScaled(roundScaled(r, precision, mode), precision)
+}
+
+// ratDecimal returns r with the given number of digits after decimal point
+func ratDecimal(r *big.Rat, digits int, mode num2words.Rounding) *decimal {
+	integer, fraction := splitScaled(roundScaled(r, digits, mode), digits)
+	return &decimal{
+		negative: r.Sign() < 0,
+		integer:  integer,
+		fraction: fraction,
+	}
+}
+
+// isZero returns true if all digits are zero
+func (d *decimal) isZero() bool {
+	return d.integer == "0" && strings.Trim(d.fraction, "0") == ""
+}
+
+// addDecimal adds words of d, based on o.Decimal
+func addDecimal(l *tokens.List, d *decimal, o *num2words.Options) error {
+	d.round(o.Precision, o.Rounding)
+	fraction := d.fraction
+	if !o.TrailingZeros {
+		fraction = strings.TrimRight(fraction, "0")
+	}
+	if d.negative && !d.isZero() {
+		addSign(l)
+	}
+	if o.Decimal == num2words.DecimalFraction {
+		return addFraction(l, d.integer, fraction, o)
+	}
+	err := addDigits(l, d.integer, o)
+	if err != nil {
+		return err
+	}
+	if fraction == "" {
+		return nil
+	}
+	l.Space(" ")
+	l.Add(num2words.TokenDecimalPoint, en_point, -1)
+	for i := range len(fraction) {
+		l.Space(" ")
+		l.Add(num2words.TokenDigit, small_words[uint16(fraction[i]-'0')], -1)
+	}
+	return nil
+}
+
+// addFraction adds words of decimal with num2words.DecimalFraction style
+// "Three and Fourteen Hundredths"
+func addFraction(l *tokens.List, integer string, fraction string, o *num2words.Options) error {
+	if strings.Trim(fraction, "0") == "" {
+		// "2.00" is "Two", even with num2words.WithTrailingZeros
+		fraction = ""
+	}
+	if fraction == "" || integer != "0" {
+		err := addDigits(l, integer, o)
+		if err != nil {
+			return err
+		}
+	}
+	if fraction == "" {
+		return nil
+	}
+	if integer != "0" {
+		l.Conjunction(en_british_and, -1)
+	}
+	numerator := trimZeros(fraction)
+	err := addDigits(l, numerator, o)
+	if err != nil {
+		return err
+	}
+	l.Space(" ")
+	addDenominator(l, len(fraction), o)
+	last := l.Last()
+	last.Text = ordinalWord(last.Text)
+	if numerator != "1" {
+		last.Text += "s"
+	}
+	return nil
+}
+
+// addDenominator adds cardinal words of 10^k without "One", for k > 0
+func addDenominator(l *tokens.List, k int, o *num2words.Options) {
+	i := k / 3
+	switch k % 3 {
+	case 1:
+		l.Add(num2words.TokenTeen, ten_words[1], i)
+	case 2:
+		l.Add(num2words.TokenHundred, ten_words[2], i)
+	}
+	if i > 0 {
+		if k%3 != 0 {
+			l.Space(" ")
+		}
+		addOrder(l, i, o)
+	}
+}
+
+// ConvertDecimalStringTokens is like ConvertDecimalString, but returns tokens
+func ConvertDecimalStringTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	d, err := parseDecimal(str, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	l := &tokens.List{}
+	err = addDecimal(l, d, o)
+	if err != nil {
+		return nil, err
+	}
+	return l.Result(o), nil
+}
+
+// ConvertDecimalString: "3.14" => "Three Point One Four", or
+// "Three and Fourteen Hundredths" with num2words.DecimalFraction
+// The integer part is parsed like ConvertString
+func ConvertDecimalString(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertDecimalStringTokens(str, opts...)
+	if err != nil {
+		return "", err
+	}
+	return num2words.JoinTokens(result), nil
+}
+
+// convertDecimal converts d, which is always valid
+func convertDecimal(d *decimal, o *num2words.Options) string {
+	l := &tokens.List{}
+	err := addDecimal(l, d, o)
+	if err != nil {
+		panic(err)
+	}
+	return num2words.JoinTokens(l.Result(o))
+}
+
+// ConvertBigFloat is like ConvertDecimalString for f, which must be finite
+// It uses the smallest number of digits that represent f uniquely (for
+// example 0.1 for float64(0.1)), then rounds it to precision
+func ConvertBigFloat(f *big.Float, opts ...num2words.Option) string {
+	if f.IsInf() {
+		panic("english: ConvertBigFloat: infinite number")
+	}
+	d, err := parseDecimal(f.Text('f', -1), false)
+	if err != nil {
+		panic(err)
+	}
+	return convertDecimal(d, newOptions(opts))
+}
+
+// finiteDigits returns the number of decimal digits of 1/denom, and false
+// if 1/denom has no finite decimal form
+func finiteDigits(denom *big.Int) (int, bool) {
+	d := new(big.Int).Set(denom)
+	rem := new(big.Int)
+	count := func(p int64) int {
+		bp := big.NewInt(p)
+		n := 0
+		for {
+			q, r := new(big.Int).QuoRem(d, bp, rem)
+			if r.Sign() != 0 {
+				return n
+			}
+			d = q
+			n++
+		}
+	}
+	twos := count(2)
+	fives := count(5)
+	if d.Cmp(big_one) != 0 {
+		return 0, false
+	}
+	return max(twos, fives), true
+}
+
+// ConvertBigRat is like ConvertDecimalString for r
+// With negative precision, it uses all digits of r if it has a finite
+// decimal form, and 10 digits otherwise (like 1/3)
+func ConvertBigRat(r *big.Rat, opts ...num2words.Option) string {
+	o := newOptions(opts)
+	digits := o.Precision
+	if digits < 0 {
+		var ok bool
+		digits, ok = finiteDigits(r.Denom())
+		if !ok {
+			digits = maxRatDigits
+		}
+	}
+	return convertDecimal(ratDecimal(r, digits, o.Rounding), o)
+}
@@ -0,0 +1,136 @@
+package english_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertDecimalString(t *testing.T) {
+	is := is.New(t).Lax()
+	fraction := num2words.WithDecimalStyle(num2words.DecimalFraction)
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertDecimalString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(words, expected)
+	}
+	test("3.14", "Three Point One Four")
+	test("3.14", "Three and Fourteen Hundredths", fraction)
+	test("3", "Three")
+	test("3", "Three", fraction)
+	test("3.", "Three")
+	test(".5", "Zero Point Five")
+	test(".5", "Five Tenths", fraction)
+	test("0.1", "One Tenth", fraction)
+	test("0.01", "One Hundredth", fraction)
+	test("0.001", "One Thousandth", fraction)
+	test("0.0001", "One Ten Thousandth", fraction)
+	test("0.00025", "Twenty Five Hundred Thousandths", fraction)
+	test("0.000001", "One Millionth", fraction)
+	test("0.0000007", "Seven Ten Millionths", fraction)
+	test("1.05", "One Point Zero Five")
+	test("1.05", "One and Five Hundredths", fraction)
+	test("-2.5", "Negative Two Point Five")
+	test("-0.5", "Negative Five Tenths", fraction)
+	test("-0.00", "Zero")
+	test("1,234.5", "One Thousand, Two Hundred Thirty Four Point Five")
+	test("۳٫۱۴", "Three Point One Four")
+	test(" +3.14 ", "Three Point One Four")
+
+	// trailing zeros
+	test("2.50", "Two Point Five")
+	test("2.50", "Two Point Five Zero", num2words.WithTrailingZeros(true))
+	test("3.140", "Three and Fourteen Hundredths", fraction)
+	test("3.140", "Three and One Hundred Forty Thousandths",
+		fraction, num2words.WithTrailingZeros(true))
+	test("2.00", "Two", fraction, num2words.WithTrailingZeros(true))
+	test("-0.0", "Zero", fraction, num2words.WithTrailingZeros(true))
+	test("2.000", "Two")
+	test("2.000", "Two Point Zero Zero Zero", num2words.WithTrailingZeros(true))
+
+	// precision
+	test("3.14159", "Three Point One Four", num2words.WithPrecision(2))
+	test("3.145", "Three Point One Five", num2words.WithPrecision(2))
+	test("-3.145", "Negative Three Point One Five", num2words.WithPrecision(2))
+	test("9.996", "Ten", num2words.WithPrecision(2))
+	test("9.996", "Ten Point Zero Zero",
+		num2words.WithPrecision(2), num2words.WithTrailingZeros(true))
+	test("2.5", "Three", num2words.WithPrecision(0))
+	test("2.5", "Two Point Five Zero Zero",
+		num2words.WithPrecision(3), num2words.WithTrailingZeros(true))
+
+	test("3.14", "three and fourteen hundredths",
+		fraction, num2words.WithCase(num2words.CaseLower))
+	test("21.21", "Twenty-One and Twenty-One Hundredths",
+		fraction, num2words.WithHyphenation(true))
+}
+
+func TestConvertDecimalStringErrors(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, target error, offset int, opts ...num2words.Option) {
+		_, err := english.ConvertDecimalString(str, opts...)
+		is.Msg("str=%#v", str).True(errors.Is(err, target))
+		if offset < 0 {
+			return
+		}
+		var charErr *num2words.InvalidCharError
+		is.Msg("str=%#v", str).True(errors.As(err, &charErr))
+		if charErr != nil {
+			is.Msg("str=%#v", str).Equal(charErr.Offset, offset)
+		}
+	}
+	test("", num2words.ErrEmpty, -1)
+	test(".", num2words.ErrInvalidChar, 0)
+	test("3.1.4", num2words.ErrInvalidChar, 3)
+	test("3.1x", num2words.ErrInvalidChar, 3)
+	test("3.1 4", num2words.ErrInvalidChar, 3)
+	test("a.5", num2words.ErrInvalidChar, 0)
+	test("3,5", num2words.ErrInvalidChar, 1)
+	test("-3.5", num2words.ErrUnsupportedSign, -1, num2words.WithStrict(true))
+}
+
+func TestConvertDecimalTokens(t *testing.T) {
+	is := is.New(t)
+	tokens, err := english.ConvertDecimalStringTokens("-1.5")
+	is.NotErr(err)
+	is.Equal(num2words.JoinTokens(tokens), "Negative One Point Five")
+	is.Equal(tokens[2].Kind, num2words.TokenDecimalPoint)
+	is.Equal(tokens[2].Group, -1)
+	is.Equal(tokens[3].Kind, num2words.TokenDigit)
+	is.Equal(tokens[3].Group, -1)
+}
+
+func TestConvertBigFloat(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(f *big.Float, expected string, opts ...num2words.Option) {
+		is.Msg("num=%v", f).Equal(english.ConvertBigFloat(f, opts...), expected)
+	}
+	test(big.NewFloat(3.14), "Three Point One Four")
+	test(big.NewFloat(0.1), "Zero Point One")
+	test(big.NewFloat(-2.5), "Negative Two and Five Tenths",
+		num2words.WithDecimalStyle(num2words.DecimalFraction))
+	test(big.NewFloat(1e6), "One Million")
+	test(big.NewFloat(3.14159), "Three Point One Four One Six", num2words.WithPrecision(4))
+	test(big.NewFloat(2), "Two Point Zero",
+		num2words.WithPrecision(1), num2words.WithTrailingZeros(true))
+	test(big.NewFloat(0.75), "Seventy Five Hundredths",
+		num2words.WithDecimalStyle(num2words.DecimalFraction))
+}
+
+func TestConvertBigRat(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(r *big.Rat, expected string, opts ...num2words.Option) {
+		is.Msg("num=%v", r).Equal(english.ConvertBigRat(r, opts...), expected)
+	}
+	test(big.NewRat(157, 50), "Three Point One Four")
+	test(big.NewRat(1, 8), "One Hundred Twenty Five Thousandths",
+		num2words.WithDecimalStyle(num2words.DecimalFraction))
+	test(big.NewRat(-7, 4), "Negative One Point Seven Five")
+	test(big.NewRat(1, 3), "Zero Point Three Three Three Three Three Three Three Three Three Three")
+	test(big.NewRat(2, 3), "Zero Point Six Seven", num2words.WithPrecision(2))
+	test(big.NewRat(10, 1), "Ten")
+}
@@ -0,0 +1,89 @@
+package english
+
+import (
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+const (
+	en_double = "Double"
+	en_triple = "Triple"
+)
+
+// digitWord returns word of ASCII digit c
+func digitWord(c byte, o *num2words.Options) string {
+	if c == '0' && o.Oh {
+		return en_oh
+	}
+	return small_words[uint16(c-'0')]
+}
+
+// repeatSize returns the number of digits read together from a run of n
+// equal digits: 2 => "Double", 3 => "Triple", and 4 => "Double Double"
+func repeatSize(n int) int {
+	switch {
+	case n == 4:
+		return 2
+	case n >= 3:
+		return 3
+	}
+	return n
+}
+
+// addDigitSequence adds words of each digit, with group index counted
+// from the last group
+func addDigitSequence(l *tokens.List, groups []string, o *num2words.Options) {
+	for gi, digits := range groups {
+		group := len(groups) - 1 - gi
+		if gi > 0 {
+			l.Conjunction(o.DigitSeparator, group)
+		}
+		for i := 0; i < len(digits); {
+			if i > 0 {
+				l.Space(" ")
+			}
+			size := 1
+			if o.DigitRepeat {
+				n := 1
+				for i+n < len(digits) && digits[i+n] == digits[i] {
+					n++
+				}
+				size = repeatSize(n)
+				switch size {
+				case 2:
+					l.Add(num2words.TokenQualifier, en_double, group)
+					l.Space(" ")
+				case 3:
+					l.Add(num2words.TokenQualifier, en_triple, group)
+					l.Space(" ")
+				}
+			}
+			l.Add(num2words.TokenDigit, digitWord(digits[i], o), group)
+			i += size
+		}
+	}
+}
+
+// ConvertDigitsTokens is like ConvertDigits, but returns tokens
+func ConvertDigitsTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	groups, err := numstr.SplitDigits(str, o.DigitGroups, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	l := &tokens.List{}
+	addDigitSequence(l, groups, o)
+	return l.Result(o), nil
+}
+
+// ConvertDigits reads a code or phone number digit by digit, like
+// "Four One Five, Five Five Five, Oh One Two Three" (with num2words.WithOh)
+// Groups are made by separators of str, or by num2words.WithDigitGroups
+func ConvertDigits(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertDigitsTokens(str, opts...)
+	if err != nil {
+		return "", err
+	}
+	return num2words.JoinTokens(result), nil
+}
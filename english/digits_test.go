@@ -0,0 +1,65 @@
+package english_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertDigits(t *testing.T) {
+	is := is.New(t).Lax()
+	oh := num2words.WithOh(true)
+	repeat := num2words.WithDigitRepeat(true)
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertDigits(str, opts...)
+		is.NotErr(err)
+		is.Msg("str=%#v", str).Equal(words, expected)
+	}
+	test("415 555 0123", "Four One Five, Five Five Five, Zero One Two Three")
+	test("415 555 0123", "four one five, five five five, oh one two three",
+		oh, num2words.WithCase(num2words.CaseLower))
+	test("(415) 555-0123", "Four One Five, Five Five Five, Oh One Two Three", oh)
+	test("4155550123", "Four One Five, Five Five Five, Oh One Two Three",
+		oh, num2words.WithDigitGroups(3, 3, 4))
+	test("4155550123", "Four One Five Five Five Five Oh One Two Three", oh)
+	test("007", "Zero Zero Seven")
+	test("007", "Double Oh Seven", oh, repeat)
+	test("415 555 0123", "Four One Five, Triple Five, Oh One Two Three", oh, repeat)
+	test("5555", "Double Five Double Five", repeat)
+	test("55555", "Triple Five Double Five", repeat)
+	test("1000000", "One Triple Zero Triple Zero", repeat)
+	test("4111111111111111", "Four One One One; One One One One; One One One One; One One One One",
+		num2words.WithDigitGroups(4),
+		num2words.WithDigitSeparator("; "),
+	)
+	test("12-34", "One Two Three Four", num2words.WithDigitSeparator(" "))
+
+	_, err := english.ConvertDigits("415-CALL")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	_, err = english.ConvertDigits("  ")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+}
+
+func TestConvertDigitsTokens(t *testing.T) {
+	is := is.New(t)
+	tokens, err := english.ConvertDigitsTokens("12 33", num2words.WithDigitRepeat(true))
+	is.NotErr(err)
+	is.Equal(num2words.JoinTokens(tokens), "One Two, Double Three")
+	kinds := []num2words.TokenKind{}
+	groups := []int{}
+	for _, t := range tokens {
+		kinds = append(kinds, t.Kind)
+		groups = append(groups, t.Group)
+	}
+	is.Equal(kinds, []num2words.TokenKind{
+		num2words.TokenDigit,
+		num2words.TokenDigit,
+		num2words.TokenConjunction,
+		num2words.TokenQualifier,
+		num2words.TokenDigit,
+	})
+	is.Equal(groups, []int{1, 1, 0, 0, 0})
+}
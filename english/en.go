@@ -15,21 +15,37 @@ package english
 import (
 	"math/big"
 	"strconv"
-	"strings"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/groups"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
 )
 
 const (
-	en_and     = ", "
-	en_zero    = "Zero"
-	en_hundred = "Hundred"
-)
+	en_and      = ", "
+	en_zero     = "Zero"
+	en_hundred  = "Hundred"
+	en_negative = "Negative"
 
-var (
-	big_zero     = big.NewInt(0)
-	big_ten      = big.NewInt(10)
-	big_thausand = big.NewInt(1000)
+	// put between compound tens with Options.Hyphenate: "Twenty-One"
+	en_hyphen = "-"
+
+	// used with Options.BritishAnd
+	en_british_and = " and "
 )
 
+var defaultOptions = num2words.Options{
+	GroupSeparator: en_and,
+	Conjunction:    " ",
+	Precision:      -1,
+	DigitSeparator: en_and,
+
+	SignificantDigits: 2,
+}
+
+var big_zero = big.NewInt(0)
+
 var small_words = map[uint16]string{
 	0:  en_zero,
 	1:  "One",
@@ -61,11 +77,31 @@ var small_words = map[uint16]string{
 	90: "Ninety",
 }
 
+// big_words[i] is the short scale name of 1000^i, see scaleWord for
+// higher orders
 var big_words = []string{
 	"One",
 	"Thousand",
 	"Million",
 	"Billion",
+	"Trillion",
+	"Quadrillion",
+	"Quintillion",
+	"Sextillion",
+	"Septillion",
+	"Octillion",
+	"Nonillion",
+	"Decillion",
+	"Undecillion",
+	"Duodecillion",
+	"Tredecillion",
+	"Quattuordecillion",
+	"Quindecillion",
+	"Sexdecillion",
+	"Septendecillion",
+	"Octodecillion",
+	"Novemdecillion",
+	"Vigintillion",
 }
 
 func extractGroupsByString(numStr string) ([]uint16, error) {
@@ -90,150 +126,230 @@ func extractGroupsByString(numStr string) ([]uint16, error) {
 	return groups, nil
 }
 
-func bigIntCountDigits(bnBytes []byte) int {
-	bn := &big.Int{}
-	bn.SetBytes(bnBytes)
-	if bn.Cmp(big_zero) == 0 {
-		return 1
-	}
-	count := 0
-	for bn.Cmp(big_zero) != 0 {
-		bn.Div(bn, big_ten)
-		count++
-	}
-	return count
+func addSign(l *tokens.List) {
+	l.Add(num2words.TokenSign, en_negative, -1)
+	l.Space(" ")
 }
 
-func extractGroupsByBigInt(bn *big.Int, digitCount int) []uint16 {
-	groupCount := digitCount / 3
-	groups := make([]uint16, groupCount)
-	for i := range groupCount {
-		mod := &big.Int{}
-		div := &big.Int{}
-		div.DivMod(bn, big_thausand, mod)
-		groups[i] = uint16(mod.Uint64())
-		bn = div
-	}
-	m := digitCount % 3
-	if m > 0 {
-		groups = append(groups, uint16(bn.Uint64()))
+// addOrder adds scale word of group index i > 0
+func addOrder(l *tokens.List, i int, o *num2words.Options) {
+	l.Add(num2words.TokenScale, orderWord(i, o), i)
+}
+
+// groupSeparator returns the separator put before non-zero group p with
+// index i, when there is a higher non-zero group
+// With Options.BritishAnd, the last group is joined with "and" if it has
+// no hundreds: "One Thousand and Five"
+func groupSeparator(p uint16, i int, o *num2words.Options) string {
+	if o.BritishAnd && i == 0 && p < 100 {
+		return en_british_and
 	}
-	return groups
+	return o.GroupSeparator
 }
 
-func joinReversed(groups []string, sep string) string {
-	r_groups := make([]string, len(groups))
-	n := len(groups)
-	for i := range n {
-		r_groups[n-i-1] = groups[i]
+// addGroup adds words of non-zero group p with index i
+func addGroup(l *tokens.List, p uint16, i int, o *num2words.Options) {
+	addSmall(l, p, i, o)
+	if i > 0 {
+		l.Space(" ")
+		addOrder(l, i, o)
 	}
-	return strings.Join(r_groups, sep)
 }
 
 // n >= 1000
-func convertLarge(groups []uint16) string {
-	k := len(groups)
-	w_groups := []string{}
-	for i := range k {
+func addLarge(l *tokens.List, groups []uint16, o *num2words.Options) {
+	start := l.Len()
+	for i := len(groups) - 1; i >= 0; i-- {
 		p := groups[i]
 		if p == 0 {
 			continue
 		}
-		if i == 0 {
-			w_groups = append(w_groups, convertSmall(p))
-			continue
+		if l.Len() > start {
+			l.Conjunction(groupSeparator(p, i, o), i)
 		}
-		order := ""
-		if i < len(big_words) {
-			order = big_words[i]
-		} else {
-			order = ""
-			d := i / 3
-			m := i % 3
-			t9 := big_words[3]
-			for j := range d {
-				if j > 0 {
-					order += " "
-				}
-				order += t9
-			}
-			if m != 0 {
-				if order != "" {
-					order = " " + order
-				}
-				order = big_words[m] + order
+		if longThousand(i, o) {
+			// "Five Thousand Two Hundred Billion"
+			addGroup(l, p, i, o)
+			i--
+			if groups[i] != 0 {
+				l.Space(" ")
+				addSmall(l, groups[i], i, o)
 			}
+			l.Space(" ")
+			addOrder(l, i, o)
+			continue
 		}
-		w_groups = append(w_groups, convertSmall(p)+" "+order)
+		addGroup(l, p, i, o)
+	}
+	if l.Len() == start {
+		l.Add(num2words.TokenDigit, en_zero, 0)
 	}
-	return joinReversed(w_groups, en_and)
 }
 
 // num < 1000
-func convertSmall(num uint16) string {
+func addSmall(l *tokens.List, num uint16, group int, o *num2words.Options) {
 	{
 		word, ok := small_words[num]
 		if ok {
-			return word
+			l.Add(tokens.SmallKind(num), word, group)
+			return
 		}
 	}
 	ones := num % 10
 	tens := (num % 100) / 10
 	hundreds := num / 100
-	result := ""
 	if hundreds != 0 {
-		result += small_words[hundreds] + " " + en_hundred
-		if tens != 0 || ones != 0 {
-			result += " "
+		l.Add(num2words.TokenDigit, small_words[hundreds], group)
+		l.Space(" ")
+		l.Add(num2words.TokenHundred, en_hundred, group)
+		switch {
+		case tens == 0 && ones == 0:
+		case o.BritishAnd:
+			l.Conjunction(en_british_and, group)
+		default:
+			l.Conjunction(o.Conjunction, group)
 		}
 	}
 	if tens != 0 {
 		word, ok := small_words[num%100]
 		if ok {
-			result += word
-			return result // OK, Done
+			l.Add(tokens.SmallKind(num%100), word, group)
+			return // OK, Done
 		}
-		result += small_words[tens*10]
+		l.Add(num2words.TokenTens, small_words[tens*10], group)
 		if ones != 0 {
-			result += " "
+			if o.Hyphenate {
+				l.Space(en_hyphen)
+			} else {
+				l.Space(" ")
+			}
 		}
 	}
 	if ones != 0 {
-		result += small_words[ones]
+		l.Add(num2words.TokenDigit, small_words[ones], group)
 	}
-	return result
 }
 
-// ConvertString: only for non-negative integers
-func ConvertString(str string) (string, error) {
+func newOptions(opts []num2words.Option) *num2words.Options {
+	return num2words.NewOptions(defaultOptions, opts)
+}
+
+func addDigits(l *tokens.List, str string, o *num2words.Options) error {
+	if isIndian(o) {
+		addIndian(l, str, o)
+		return nil
+	}
 	if len(str) <= 3 { // n <= 999
 		n_i64, err := strconv.ParseUint(str, 10, 64)
 		if err != nil {
-			return "", err
+			return err
 		}
-		return convertSmall(uint16(n_i64)), nil
+		addSmall(l, uint16(n_i64), 0, o)
+		return nil
 	}
 	// n >= 1000
 	groups, err := extractGroupsByString(str)
+	if err != nil {
+		return err
+	}
+	addLarge(l, groups, o)
+	return nil
+}
+
+func stringTokens(str string, o *num2words.Options) (*tokens.List, error) {
+	n, err := numstr.Parse(str, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	l := &tokens.List{}
+	if n.Negative && n.Digits != "0" {
+		addSign(l)
+	}
+	err = addDigits(l, n.Digits, o)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// ConvertStringTokens is like ConvertString, but returns tokens
+func ConvertStringTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	l, err := stringTokens(str, o)
+	if err != nil {
+		return nil, err
+	}
+	return l.Result(o), nil
+}
+
+// ConvertString: str may have a sign and thousands separators, unless
+// strict option is enabled
+func ConvertString(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertStringTokens(str, opts...)
 	if err != nil {
 		return "", err
 	}
-	return convertLarge(groups), nil
+	return num2words.JoinTokens(result), nil
 }
 
-// ConvertBigInt: only for non-negative integers
-func ConvertBigInt(bn *big.Int) string {
-	digitCount := bigIntCountDigits(bn.Bytes())
-	if digitCount <= 3 { // n <= 999
-		return convertSmall(uint16(bn.Uint64()))
+// addBigInt adds words of absolute value of bn
+func addBigInt(l *tokens.List, bn *big.Int, o *num2words.Options) {
+	if isIndian(o) {
+		addIndian(l, new(big.Int).Abs(bn).String(), o)
+		return
+	}
+	b_groups := groups.FromBigInt(bn)
+	if len(b_groups) == 1 { // n <= 999
+		addSmall(l, b_groups[0], 0, o)
+		return
 	}
 	// n >= 1000
-	return convertLarge(extractGroupsByBigInt(bn, digitCount))
+	addLarge(l, b_groups, o)
 }
 
-func ConvertBigIntSigned(bn *big.Int) string {
+// ConvertBigIntTokens is like ConvertBigIntSigned, but returns tokens
+func ConvertBigIntTokens(bn *big.Int, opts ...num2words.Option) []num2words.Token {
+	o := newOptions(opts)
+	l := &tokens.List{}
 	if bn.Cmp(big_zero) < 0 {
-		return "Negative " + ConvertBigInt(bn.Abs(bn))
+		addSign(l)
+	}
+	addBigInt(l, bn, o)
+	return l.Result(o)
+}
+
+// ConvertBigInt: only for non-negative integers
+func ConvertBigInt(bn *big.Int, opts ...num2words.Option) string {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	addBigInt(l, bn, o)
+	return num2words.JoinTokens(l.Result(o))
+}
+
+func ConvertBigIntSigned(bn *big.Int, opts ...num2words.Option) string {
+	return num2words.JoinTokens(ConvertBigIntTokens(bn, opts...))
+}
+
+func addUint64(l *tokens.List, n uint64, o *num2words.Options) {
+	if isIndian(o) {
+		addIndian(l, strconv.FormatUint(n, 10), o)
+		return
+	}
+	if n < 1000 {
+		addSmall(l, uint16(n), 0, o)
+		return
+	}
+	addLarge(l, groups.FromUint64(n), o)
+}
+
+// Convert: for any native integer type, signed or unsigned
+func Convert[T num2words.Integer](n T, opts ...num2words.Option) string {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	abs, negative := groups.Abs(n)
+	if negative {
+		addSign(l)
 	}
-	return ConvertBigInt(bn)
+	addUint64(l, abs, o)
+	return num2words.JoinTokens(l.Result(o))
 }
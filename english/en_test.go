@@ -3,13 +3,16 @@ package english_test
 import (
 	"bufio"
 	"compress/gzip"
+	"errors"
 	"log"
+	"math"
 	"math/big"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
 	"github.com/ilius/num2words/english"
 )
 
@@ -93,6 +96,90 @@ func TestConvertBigIntSigned(t *testing.T) {
 	}
 }
 
+func TestConvertStringErrors(t *testing.T) {
+	is := is.New(t).Lax()
+	_, err := english.ConvertString("")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+
+	// decimal comma is not a thousands separator
+	_, err = english.ConvertString("1,00")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	_, err = english.ConvertString("-0,001")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+
+	_, err = english.ConvertString("1234x6")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	var charErr *num2words.InvalidCharError
+	if is.True(errors.As(err, &charErr)) {
+		is.Equal(charErr.Offset, 4)
+		is.Equal(charErr.Char, 'x')
+	}
+
+	_, err = english.ConvertString("12")
+	is.NotErr(err)
+}
+
+func TestConvertStringNormalize(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, words string) {
+		actual, err := english.ConvertString(str)
+		is.Msg("num=%#v", str).NotErr(err)
+		is.Msg("num=%#v", str).Equal(actual, words)
+	}
+	test("-42", "Negative Forty Two")
+	test("+42", "Forty Two")
+	test("-0", "Zero")
+	test(" 42\n", "Forty Two")
+	test("1,234,567", "One Million, Two Hundred Thirty Four Thousand, Five Hundred Sixty Seven")
+	test("1_000", "One Thousand")
+	test("1 000", "One Thousand")
+	test("0000", "Zero")
+	test("0001", "One")
+	test("000000", "Zero")
+}
+
+func TestConvertStringStrict(t *testing.T) {
+	is := is.New(t).Lax()
+	strict := num2words.WithStrict(true)
+	_, err := english.ConvertString("-42", strict)
+	is.True(errors.Is(err, num2words.ErrUnsupportedSign))
+	_, err = english.ConvertString("1,000", strict)
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	words, err := english.ConvertString("0000", strict)
+	is.NotErr(err)
+	is.Equal(words, "Zero")
+	words, err = english.ConvertString("001000", strict)
+	is.NotErr(err)
+	is.Equal(words, "One Thousand")
+}
+
+func TestConvertGeneric(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		if !tc.BigInt.IsInt64() {
+			continue
+		}
+		n := tc.BigInt.Int64()
+		is.Msg("num=%v", n).Equal(english.Convert(n), tc.Words)
+		is.Msg("num=%v", n).Equal(english.Convert(uint64(n)), tc.Words)
+		bn_neg := big.NewInt(-n)
+		is.Msg("num=%v", -n).Equal(english.Convert(-n), english.ConvertBigIntSigned(bn_neg))
+	}
+	testLimit := func(n string, words string) {
+		bn := &big.Int{}
+		bn.SetString(n, 10)
+		is.Msg("num=%v", n).Equal(words, english.ConvertBigIntSigned(bn))
+	}
+	testLimit("-9223372036854775808", english.Convert(int64(math.MinInt64)))
+	testLimit("9223372036854775807", english.Convert(int64(math.MaxInt64)))
+	testLimit("18446744073709551615", english.Convert(uint64(math.MaxUint64)))
+	testLimit("-128", english.Convert(int8(math.MinInt8)))
+	testLimit("255", english.Convert(uint8(255)))
+	testLimit("-2147483648", english.Convert(int32(math.MinInt32)))
+	testLimit("0", english.Convert(0))
+	testLimit("-7", english.Convert(-7))
+}
+
 func Benchmark_convert_string_bigInt(b *testing.B) {
 	b.Run("string", func(b *testing.B) {
 		for _, tc := range testData {
@@ -109,3 +196,42 @@ func Benchmark_convert_string_bigInt(b *testing.B) {
 /*
 go test -bench=. -benchtime=1000x -count=5
 */
+
+func TestConvertStringOptions(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, words string, opts ...num2words.Option) {
+		actual, err := english.ConvertString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(actual, words)
+	}
+	test("1234567", "One Million, Two Hundred Thirty Four Thousand, Five Hundred Sixty Seven")
+	test(
+		"1234567",
+		"ONE MILLION, TWO HUNDRED THIRTY FOUR THOUSAND, FIVE HUNDRED SIXTY SEVEN",
+		num2words.WithCase(num2words.CaseUpper),
+	)
+	test(
+		"1234567",
+		"one million two hundred thirty-four thousand five hundred sixty-seven",
+		num2words.WithCase(num2words.CaseLower),
+		num2words.WithGroupSeparator(" "),
+		num2words.WithHyphenation(true),
+	)
+	test(
+		"1105",
+		"One thousand, one hundred and five",
+		num2words.WithCase(num2words.CaseSentence),
+		num2words.WithConjunction(" and "),
+	)
+	test("21", "Twenty-One", num2words.WithHyphenation(true))
+	test("20", "Twenty", num2words.WithHyphenation(true))
+	test("0", "zero", num2words.WithCase(num2words.CaseLower))
+}
+
+func TestConvertBigIntSignedOptions(t *testing.T) {
+	is := is.New(t)
+	is.Equal(
+		english.ConvertBigIntSigned(big.NewInt(-21), num2words.WithCase(num2words.CaseLower)),
+		"negative twenty one",
+	)
+}
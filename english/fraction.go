@@ -0,0 +1,114 @@
+package english
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+var big_one = big.NewInt(1)
+
+// denominators with special words, singular and plural
+var fraction_words = map[int64][2]string{
+	2: {"Half", "Halves"},
+	4: {"Quarter", "Quarters"},
+}
+
+// powerOfTen returns k if n is 10^k, or -1
+func powerOfTen(n *big.Int) int {
+	str := n.String()
+	if str[0] != '1' || strings.Trim(str[1:], "0") != "" {
+		return -1
+	}
+	return len(str) - 1
+}
+
+// addFractionDenom adds the denominator words: "Fifths", "Half",
+// "Hundredths", "Twenty Firsts"
+func addFractionDenom(l *tokens.List, den *big.Int, plural bool, o *num2words.Options) {
+	if den.IsInt64() {
+		words, ok := fraction_words[den.Int64()]
+		if ok {
+			word := words[0]
+			if plural {
+				word = words[1]
+			}
+			l.Add(num2words.TokenDigit, word, 0)
+			return
+		}
+	}
+	if k := powerOfTen(den); k >= 2 {
+		// "Hundredth" instead of "One Hundredth"
+		addDenominator(l, k, o)
+	} else {
+		addBigInt(l, den, o)
+	}
+	last := l.Last()
+	last.Text = ordinalWord(last.Text)
+	if plural {
+		last.Text += "s"
+	}
+}
+
+// addRatio adds words of num/den, both non-negative and den != 0
+// Improper fractions are read as mixed numbers: "Three and Two Fifths"
+func addRatio(l *tokens.List, num *big.Int, den *big.Int, o *num2words.Options) {
+	whole, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		addBigInt(l, whole, o)
+		return
+	}
+	if whole.Sign() != 0 {
+		addBigInt(l, whole, o)
+		l.Conjunction(en_british_and, 0)
+	}
+	numStart := l.Len()
+	addBigInt(l, rem, o)
+	denStart := l.Len()
+	addFractionDenom(l, den, rem.Cmp(big_one) != 0, o)
+	// "Two-Fifths", but "Twenty One Hundredths" and "Three Twenty Firsts"
+	sep := " "
+	if o.Hyphenate && denStart-numStart == 1 && l.Len()-denStart == 1 {
+		sep = en_hyphen
+	}
+	l.Tokens[denStart].Sep = sep
+}
+
+// fractionTokens returns tokens of num/den, den must not be zero
+func fractionTokens(num *big.Int, den *big.Int, o *num2words.Options) []num2words.Token {
+	l := &tokens.List{}
+	if num.Sign()*den.Sign() < 0 {
+		addSign(l)
+	}
+	num = new(big.Int).Abs(num)
+	den = new(big.Int).Abs(den)
+	if o.ReduceFraction {
+		gcd := new(big.Int).GCD(nil, nil, num, den)
+		num.Quo(num, gcd)
+		den.Quo(den, gcd)
+	}
+	addRatio(l, num, den, o)
+	return l.Result(o)
+}
+
+// ConvertFractionTokens is like ConvertFraction, but returns tokens
+func ConvertFractionTokens(r *big.Rat, opts ...num2words.Option) []num2words.Token {
+	return fractionTokens(r.Num(), r.Denom(), newOptions(opts))
+}
+
+// ConvertFraction: 17/5 => "Three and Two Fifths", 3/4 => "Three Quarters"
+// r is always in lowest terms, see ConvertFractionParts to keep the terms
+func ConvertFraction(r *big.Rat, opts ...num2words.Option) string {
+	return num2words.JoinTokens(ConvertFractionTokens(r, opts...))
+}
+
+// ConvertFractionParts is like ConvertFraction for num/den, without reducing
+// it ("Four Eighths"), unless num2words.WithReduceFraction(true) is given
+func ConvertFractionParts(num *big.Int, den *big.Int, opts ...num2words.Option) (string, error) {
+	if den.Sign() == 0 {
+		return "", num2words.ErrZeroDenominator
+	}
+	return num2words.JoinTokens(fractionTokens(num, den, newOptions(opts))), nil
+}
@@ -0,0 +1,92 @@
+package english_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertFraction(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(a int64, b int64, expected string, opts ...num2words.Option) {
+		r := big.NewRat(a, b)
+		is.Msg("num=%v", r).Equal(english.ConvertFraction(r, opts...), expected)
+	}
+	test(0, 1, "Zero")
+	test(1, 2, "One Half")
+	test(3, 2, "One and One Half")
+	test(5, 2, "Two and One Half")
+	test(1, 4, "One Quarter")
+	test(3, 4, "Three Quarters")
+	test(1, 3, "One Third")
+	test(2, 3, "Two Thirds")
+	test(2, 5, "Two Fifths")
+	test(17, 5, "Three and Two Fifths")
+	test(7, 16, "Seven Sixteenths")
+	test(1, 8, "One Eighth")
+	test(5, 9, "Five Ninths")
+	test(1, 12, "One Twelfth")
+	test(3, 20, "Three Twentieths")
+	test(1, 21, "One Twenty First")
+	test(5, 32, "Five Thirty Seconds")
+	test(7, 100, "Seven Hundredths")
+	test(1, 1000, "One Thousandth")
+	test(3, 1000000, "Three Millionths")
+	test(1, 101, "One One Hundred First")
+	test(3, 200, "Three Two Hundredths")
+	test(4, 1, "Four")
+	test(-3, 4, "Negative Three Quarters")
+	test(-17, 5, "Negative Three and Two Fifths")
+	test(17, 5, "three and two-fifths",
+		num2words.WithCase(num2words.CaseLower),
+		num2words.WithHyphenation(true),
+	)
+	test(3, 4, "Three-Quarters", num2words.WithHyphenation(true))
+}
+
+func TestConvertFractionParts(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(a int64, b int64, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertFractionParts(big.NewInt(a), big.NewInt(b), opts...)
+		is.NotErr(err)
+		is.Msg("num=%v/%v", a, b).Equal(words, expected)
+	}
+	reduce := num2words.WithReduceFraction(true)
+	test(4, 8, "Four Eighths")
+	test(4, 8, "One Half", reduce)
+	hyphen := num2words.WithHyphenation(true)
+	test(7, 100, "Seven-Hundredths", hyphen)
+	test(21, 100, "Twenty-One Hundredths", hyphen)
+	test(3, 21, "Three Twenty-Firsts", hyphen)
+	test(21, 22, "Twenty-One Twenty-Seconds", hyphen)
+	test(2, 1000000, "Two-Millionths", hyphen)
+	test(3, 200, "Three Two Hundredths", hyphen)
+	test(2, 4, "Two Quarters")
+	test(2, 4, "One Half", reduce)
+	test(10, 4, "Two and Two Quarters")
+	test(10, 4, "Two and One Half", reduce)
+	test(6, 3, "Two")
+	test(0, 7, "Zero")
+	test(3, -4, "Negative Three Quarters")
+	test(-3, -4, "Three Quarters")
+	test(25, 100, "Twenty Five Hundredths")
+	test(25, 100, "One Quarter", reduce)
+
+	_, err := english.ConvertFractionParts(big.NewInt(1), big.NewInt(0))
+	is.True(errors.Is(err, num2words.ErrZeroDenominator))
+}
+
+func TestConvertFractionTokens(t *testing.T) {
+	is := is.New(t)
+	tokens := english.ConvertFractionTokens(big.NewRat(17, 5))
+	texts := []string{}
+	for _, t := range tokens {
+		texts = append(texts, t.Text)
+	}
+	is.Equal(texts, []string{"Three", "and", "Two", "Fifths"})
+	is.Equal(tokens[1].Kind, num2words.TokenConjunction)
+}
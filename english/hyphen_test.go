@@ -0,0 +1,100 @@
+package english_test
+
+import (
+	"bytes"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+var compoundTensRE = regexp.MustCompile(
+	`(?i)\b(Twenty|Thirty|Forty|Fifty|Sixty|Seventy|Eighty|Ninety) ` +
+		`(One|Two|Three|Four|Five|Six|Seven|Eight|Nine)\b`,
+)
+
+// hyphenate returns words with compound tens hyphenated
+func hyphenate(words string) string {
+	return compoundTensRE.ReplaceAllString(words, "$1-$2")
+}
+
+func TestConvertHyphenation(t *testing.T) {
+	is := is.New(t).Lax()
+	hyphen := num2words.WithHyphenation(true)
+	lower := num2words.WithCase(num2words.CaseLower)
+	test := func(n int64) {
+		str := strconv.FormatInt(n, 10)
+		expected := hyphenate(english.Convert(n))
+		words, err := english.ConvertString(str, hyphen)
+		is.NotErr(err)
+		is.Msg("num=%v", n).Equal(words, expected)
+		is.Msg("num=%v", n).Equal(english.Convert(n, hyphen), expected)
+		is.Msg("num=%v", n).Equal(english.ConvertBigInt(big.NewInt(n), hyphen), expected)
+		if n == 0 {
+			return
+		}
+		is.Msg("num=%v", n).Equal(
+			english.ConvertBigIntSigned(big.NewInt(-n), hyphen, lower),
+			strings.ToLower("Negative "+expected),
+		)
+	}
+	// all four-digit boundaries, and compound tens in every group
+	for n := range int64(10_000) {
+		test(n)
+	}
+	for _, base := range []int64{
+		10_000,
+		99_000,
+		100_000,
+		999_000,
+		1_000_000,
+		21_021_000,
+		999_999_000,
+		1_000_000_000,
+	} {
+		for n := base; n < base+100; n++ {
+			test(n)
+		}
+	}
+	test(21_021_021_021)
+	test(99_099_099_099_099)
+}
+
+func TestConvertHyphenationExamples(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		opts = append(opts, num2words.WithHyphenation(true))
+		words, err := english.ConvertString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(words, expected)
+		buf := bytes.NewBuffer(nil)
+		err = english.ConvertReader(buf, strings.NewReader(str), opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(buf.String(), expected)
+	}
+	test("21", "Twenty-One")
+	test("1021", "One Thousand, Twenty-One")
+	test("21021", "Twenty-One Thousand, Twenty-One")
+	test("99999", "Ninety-Nine Thousand, Nine Hundred Ninety-Nine")
+	test("1000021", "one million twenty-one",
+		num2words.WithCase(num2words.CaseLower),
+		num2words.WithGroupSeparator(" "),
+	)
+	test("121", "One hundred and twenty-one",
+		num2words.WithCase(num2words.CaseSentence),
+		num2words.WithBritishAnd(true),
+	)
+	test("34", "THIRTY-FOUR", num2words.WithCase(num2words.CaseUpper))
+	test("34", "Thirty-Four", num2words.WithCase(num2words.CaseTitle))
+
+	tokens, err := english.ConvertStringTokens("45", num2words.WithHyphenation(true))
+	is.NotErr(err)
+	is.Equal(len(tokens), 2)
+	is.Equal(tokens[1].Sep, "-")
+	is.Equal(tokens[1].Text, "Five")
+}
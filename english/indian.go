@@ -0,0 +1,80 @@
+package english
+
+import (
+	"strconv"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+// indian_words[k] is the name of 10^(3+2k) in Indian numbering
+var indian_words = []string{
+	"Thousand",
+	"Lakh",
+	"Crore",
+	"Arab",
+	"Kharab",
+}
+
+// isIndian returns true for Indian numbering scales
+func isIndian(o *num2words.Options) bool {
+	return o.Scale == num2words.ScaleIndian || o.Scale == num2words.ScaleIndianArab
+}
+
+// indianTop returns index of the highest word in indian_words for o,
+// higher numbers are counted with this word: "One Lakh Crore"
+func indianTop(o *num2words.Options) int {
+	if o.Scale == num2words.ScaleIndianArab {
+		return 4
+	}
+	return 2
+}
+
+// addIndian adds words of number with ASCII digits, grouped by 3-2-2
+// Token groups are indexes of Indian groups: 0 for hundreds, k+1 for
+// indian_words[k]
+func addIndian(l *tokens.List, digits string, o *num2words.Options) {
+	digits = trimZeros(digits)
+	if digits == "0" {
+		l.Add(num2words.TokenDigit, en_zero, 0)
+		return
+	}
+	top := indianTop(o)
+	topExp := 3 + 2*top
+	start := l.Len()
+	if len(digits) > topExp {
+		// "One Lakh Crore"
+		addIndian(l, digits[:len(digits)-topExp], o)
+		l.Space(" ")
+		l.Add(num2words.TokenScale, indian_words[top], top+1)
+		digits = digits[len(digits)-topExp:]
+	}
+	// value returns digits of exponents from <= e < to
+	value := func(from int, to int) uint16 {
+		if from >= len(digits) {
+			return 0
+		}
+		p, _ := strconv.ParseUint(digits[max(0, len(digits)-to):len(digits)-from], 10, 16)
+		return uint16(p)
+	}
+	for k := top - 1; k >= 0; k-- {
+		p := value(3+2*k, 5+2*k)
+		if p == 0 {
+			continue
+		}
+		if l.Len() > start {
+			l.Conjunction(groupSeparator(p, k+1, o), k+1)
+		}
+		addSmall(l, p, k+1, o)
+		l.Space(" ")
+		l.Add(num2words.TokenScale, indian_words[k], k+1)
+	}
+	p := value(0, 3)
+	if p == 0 {
+		return
+	}
+	if l.Len() > start {
+		l.Conjunction(groupSeparator(p, 0, o), 0)
+	}
+	addSmall(l, p, 0, o)
+}
@@ -0,0 +1,85 @@
+package english_test
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertIndian(t *testing.T) {
+	is := is.New(t).Lax()
+	indian := num2words.WithScale(num2words.ScaleIndian)
+	arab := num2words.WithScale(num2words.ScaleIndianArab)
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(words, expected)
+		bn, ok := new(big.Int).SetString(strings.ReplaceAll(str, ",", ""), 10)
+		is.True(ok)
+		is.Msg("num=%v", str).Equal(english.ConvertBigInt(bn, opts...), expected)
+		if bn.IsInt64() {
+			is.Msg("num=%v", str).Equal(english.Convert(bn.Int64(), opts...), expected)
+		}
+	}
+	test("0", "Zero", indian)
+	test("999", "Nine Hundred Ninety Nine", indian)
+	test("1000", "One Thousand", indian)
+	test("1,00,000", "One Lakh", indian)
+	test("1,50,00,000", "One Crore, Fifty Lakh", indian)
+	test("1,50,00,000", "One Crore Fifty Lakh", indian, num2words.WithGroupSeparator(" "))
+	test("100005", "One Lakh and Five", indian, num2words.WithBritishAnd(true))
+	test("1,00,105", "One Lakh, One Hundred and Five", indian, num2words.WithBritishAnd(true))
+	test("12,34,56,789",
+		"Twelve Crore, Thirty Four Lakh, Fifty Six Thousand, Seven Hundred Eighty Nine",
+		indian)
+	test("99,99,99,999",
+		"Ninety Nine Crore, Ninety Nine Lakh, Ninety Nine Thousand, Nine Hundred Ninety Nine",
+		indian)
+	test("1,00,00,00,000", "One Hundred Crore", indian)
+	test("10,00,00,00,000", "One Thousand Crore", indian)
+	test("10,00,00,00,00,000", "One Lakh Crore", indian)
+	test("10,00,00,00,00,00,000", "One Crore Crore", indian)
+	test("12,34,56,78,90,12,345",
+		"One Crore, Twenty Three Lakh, Forty Five Thousand, Six Hundred Seventy Eight Crore, "+
+			"Ninety Lakh, Twelve Thousand, Three Hundred Forty Five",
+		indian)
+
+	test("1,00,00,00,000", "One Arab", arab)
+	test("1,00,00,00,00,000", "One Kharab", arab)
+	test("25,30,00,00,00,005", "Twenty Five Kharab, Thirty Arab, Five", arab)
+	test("100,00,00,00,00,000", "One Hundred Kharab", arab)
+	test("1,50,00,000", "One Crore, Fifty Lakh", arab)
+}
+
+func TestConvertIndianOther(t *testing.T) {
+	is := is.New(t)
+	indian := num2words.WithScale(num2words.ScaleIndian)
+	words, err := english.ConvertString("-1,50,000", indian)
+	is.NotErr(err)
+	is.Equal(words, "Negative One Lakh, Fifty Thousand")
+	words, err = english.ConvertOrdinalString("1,00,000", indian)
+	is.NotErr(err)
+	is.Equal(words, "One Lakhth")
+	is.Equal(
+		english.ConvertBigIntSigned(big.NewInt(-2_00_00_000), indian),
+		"Negative Two Crore",
+	)
+	words, err = english.ConvertAmountString("1,25,000.50", english.Currency{
+		Major:       "Rupee",
+		MajorPlural: "Rupees",
+		Minor:       "Paisa",
+		MinorPlural: "Paise",
+		MinorDigits: 2,
+	}, indian, num2words.WithGroupSeparator(" "), num2words.WithOnly(true))
+	is.NotErr(err)
+	is.Equal(words, "One Lakh Twenty Five Thousand Rupees and Fifty Paise Only")
+
+	err = english.ConvertReader(bytes.NewBuffer(nil), strings.NewReader("100000"), indian)
+	is.True(errors.Is(err, num2words.ErrUnsupported))
+}
@@ -0,0 +1,134 @@
+package english
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+// irregular ordinal words, others add "th" (and "y" becomes "ieth")
+var ordinal_words = map[string]string{
+	"One":    "First",
+	"Two":    "Second",
+	"Three":  "Third",
+	"Five":   "Fifth",
+	"Eight":  "Eighth",
+	"Nine":   "Ninth",
+	"Twelve": "Twelfth",
+}
+
+// ordinalWord returns ordinal form of a cardinal word:
+// "Twenty" => "Twentieth", "Hundred" => "Hundredth"
+func ordinalWord(word string) string {
+	if ordinal, ok := ordinal_words[word]; ok {
+		return ordinal
+	}
+	if strings.HasSuffix(word, "y") {
+		return word[:len(word)-1] + "ieth"
+	}
+	return word + "th"
+}
+
+// toOrdinal changes the last word into ordinal form, only the last group
+// is affected: "One Thousand, Twenty One" => "One Thousand, Twenty First"
+func toOrdinal(l *tokens.List) {
+	last := l.Last()
+	last.Text = ordinalWord(last.Text)
+}
+
+// ConvertOrdinalStringTokens is like ConvertOrdinalString, but returns tokens
+func ConvertOrdinalStringTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	n, err := numstr.Parse(str, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	if n.Negative {
+		return nil, n.SignError()
+	}
+	l := &tokens.List{}
+	err = addDigits(l, n.Digits, o)
+	if err != nil {
+		return nil, err
+	}
+	toOrdinal(l)
+	return l.Result(o), nil
+}
+
+// ConvertOrdinalString: "21" => "Twenty One" => "Twenty First"
+func ConvertOrdinalString(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertOrdinalStringTokens(str, opts...)
+	if err != nil {
+		return "", err
+	}
+	return num2words.JoinTokens(result), nil
+}
+
+// ConvertOrdinalBigIntTokens is like ConvertOrdinalBigInt, but returns tokens
+func ConvertOrdinalBigIntTokens(bn *big.Int, opts ...num2words.Option) []num2words.Token {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	addBigInt(l, bn, o)
+	toOrdinal(l)
+	return l.Result(o)
+}
+
+// ConvertOrdinalBigInt: only for non-negative integers
+func ConvertOrdinalBigInt(bn *big.Int, opts ...num2words.Option) string {
+	return num2words.JoinTokens(ConvertOrdinalBigIntTokens(bn, opts...))
+}
+
+// ordinalSuffix returns "st", "nd", "rd" or "th" for number with ASCII
+// digits str
+func ordinalSuffix(str string) string {
+	if len(str) > 1 && str[len(str)-2] == '1' {
+		return "th" // 11th, 12th, 13th, 111th
+	}
+	switch str[len(str)-1] {
+	case '1':
+		return "st"
+	case '2':
+		return "nd"
+	case '3':
+		return "rd"
+	}
+	return "th"
+}
+
+// OrdinalSuffix returns "st", "nd", "rd" or "th" for absolute value of bn
+func OrdinalSuffix(bn *big.Int) string {
+	// only the last 2 digits matter
+	last2 := new(big.Int).Rem(bn, big.NewInt(100))
+	return ordinalSuffix(last2.Abs(last2).String())
+}
+
+// FormatOrdinal returns number with digits and ordinal suffix, with comma
+// between 3-digit groups: "21" => "21st", "1003" => "1,003rd"
+// str is parsed like ConvertOrdinalString
+func FormatOrdinal(str string, opts ...num2words.Option) (string, error) {
+	o := newOptions(opts)
+	n, err := numstr.Parse(str, o.Strict)
+	if err != nil {
+		return "", err
+	}
+	if n.Negative {
+		return "", n.SignError()
+	}
+	digits := strings.TrimLeft(n.Digits, "0")
+	if digits == "" {
+		digits = "0"
+	}
+	var sb strings.Builder
+	sb.Grow(len(digits) + len(digits)/3 + 2)
+	for i := range len(digits) {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteByte(digits[i])
+	}
+	sb.WriteString(ordinalSuffix(digits))
+	return sb.String(), nil
+}
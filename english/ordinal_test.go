@@ -0,0 +1,125 @@
+package english_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertOrdinalString(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertOrdinalString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(words, expected)
+		bn, _ := new(big.Int).SetString(str, 10)
+		if bn != nil {
+			is.Msg("num=%v", str).Equal(english.ConvertOrdinalBigInt(bn, opts...), expected)
+		}
+	}
+	test("0", "Zeroth")
+	test("1", "First")
+	test("2", "Second")
+	test("3", "Third")
+	test("4", "Fourth")
+	test("5", "Fifth")
+	test("6", "Sixth")
+	test("7", "Seventh")
+	test("8", "Eighth")
+	test("9", "Ninth")
+	test("10", "Tenth")
+	test("11", "Eleventh")
+	test("12", "Twelfth")
+	test("13", "Thirteenth")
+	test("19", "Nineteenth")
+	test("20", "Twentieth")
+	test("21", "Twenty First")
+	test("40", "Fortieth")
+	test("99", "Ninety Ninth")
+	test("100", "One Hundredth")
+	test("101", "One Hundred First")
+	test("112", "One Hundred Twelfth")
+	test("1000", "One Thousandth")
+	test("1003", "One Thousand, Third")
+	test("1200", "One Thousand, Two Hundredth")
+	test("1000000", "One Millionth")
+	test("2000000000000", "Two Trillionth")
+	test("21", "Twenty-First", num2words.WithHyphenation(true))
+	test("1021", "one thousand twenty-first",
+		num2words.WithHyphenation(true),
+		num2words.WithCase(num2words.CaseLower),
+		num2words.WithGroupSeparator(" "),
+	)
+	test("105", "One Hundred and Fifth", num2words.WithBritishAnd(true))
+	test("1008", "One Thousand and Eighth", num2words.WithBritishAnd(true))
+	test("1000000000", "One Milliardth", num2words.WithScale(num2words.ScaleLong))
+	test("1,002", "ONE THOUSAND, SECOND", num2words.WithCase(num2words.CaseUpper))
+}
+
+func TestConvertOrdinalStringErrors(t *testing.T) {
+	is := is.New(t)
+	_, err := english.ConvertOrdinalString("-5")
+	is.True(errors.Is(err, num2words.ErrUnsupportedSign))
+	_, err = english.ConvertOrdinalString("")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+	_, err = english.ConvertOrdinalString("5th")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+}
+
+func TestConvertOrdinalTokens(t *testing.T) {
+	is := is.New(t)
+	tokens := english.ConvertOrdinalBigIntTokens(big.NewInt(1_000_020))
+	is.Equal(num2words.JoinTokens(tokens), "One Million, Twentieth")
+	last := tokens[len(tokens)-1]
+	is.Equal(last.Kind, num2words.TokenTens)
+	is.Equal(last.Group, 0)
+}
+
+func TestOrdinalSuffix(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(n int64, expected string) {
+		is.Msg("num=%v", n).Equal(english.OrdinalSuffix(big.NewInt(n)), expected)
+	}
+	test(0, "th")
+	test(1, "st")
+	test(2, "nd")
+	test(3, "rd")
+	test(4, "th")
+	test(11, "th")
+	test(12, "th")
+	test(13, "th")
+	test(21, "st")
+	test(22, "nd")
+	test(23, "rd")
+	test(101, "st")
+	test(111, "th")
+	test(112, "th")
+	test(1003, "rd")
+	test(-21, "st")
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string) {
+		result, err := english.FormatOrdinal(str)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(result, expected)
+	}
+	test("0", "0th")
+	test("1", "1st")
+	test("21", "21st")
+	test("112", "112th")
+	test("113", "113th")
+	test("1003", "1,003rd")
+	test("1,003", "1,003rd")
+	test("1000000", "1,000,000th")
+	test("0042", "42nd")
+	test("۲۲", "22nd")
+
+	_, err := english.FormatOrdinal("-1")
+	is.True(errors.Is(err, num2words.ErrUnsupportedSign))
+}
@@ -0,0 +1,425 @@
+package english
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+)
+
+// highest group index of scale words accepted by Parse
+const maxParseGroup = 1000
+
+var (
+	// cardinal_words maps lower case words of small_words to their values
+	cardinal_words = map[string]uint16{}
+
+	// ordinal_cardinals maps lower case irregular ordinals to cardinals
+	ordinal_cardinals = map[string]string{}
+
+	// scale_groups maps lower case short scale names to group index,
+	// filled on first use of Parse
+	scale_groups     map[string]int
+	scale_groupsOnce sync.Once
+)
+
+func init() {
+	for n, word := range small_words {
+		cardinal_words[strings.ToLower(word)] = n
+	}
+	for cardinal, ordinal := range ordinal_words {
+		ordinal_cardinals[strings.ToLower(ordinal)] = strings.ToLower(cardinal)
+	}
+}
+
+func initScaleGroups() {
+	scale_groups = make(map[string]int, maxParseGroup)
+	for i := 1; i <= maxParseGroup; i++ {
+		scale_groups[strings.ToLower(scaleWord(i))] = i
+	}
+}
+
+// scaleExponent returns the exponent of 10 for a lower case scale word
+func scaleExponent(word string, o *num2words.Options) (int, bool) {
+	for k, w := range indian_words {
+		if word == strings.ToLower(w) {
+			return 3 + 2*k, true
+		}
+	}
+	scale_groupsOnce.Do(initScaleGroups)
+	long := o.Scale == num2words.ScaleLong || o.Scale == num2words.ScalePeletier
+	if name, ok := strings.CutSuffix(word, "ard"); ok {
+		// Milliard, Billiard: 10^(6n+3)
+		i, ok := scale_groups[name+"on"]
+		if !ok || i < 2 {
+			return 0, false
+		}
+		return 6*(i-1) + 3, true
+	}
+	i, ok := scale_groups[word]
+	if !ok {
+		return 0, false
+	}
+	if long && i >= 2 {
+		return 6 * (i - 1), true
+	}
+	return 3 * i, true
+}
+
+// cardinalOf returns the cardinal form of a lower case ordinal word,
+// like "first" => "one" and "twentieth" => "twenty"
+func cardinalOf(word string) (string, bool) {
+	if cardinal, ok := ordinal_cardinals[word]; ok {
+		return cardinal, true
+	}
+	if stem, ok := strings.CutSuffix(word, "ieth"); ok {
+		return stem + "y", true
+	}
+	return strings.CutSuffix(word, "th")
+}
+
+// parseWord is a word of the input with its byte offset
+type parseWord struct {
+	text   string
+	offset int
+}
+
+// splitWords splits str into words by white space, hyphens and commas
+// Numerals like "1,500" are kept as one word
+func splitWords(str string) []parseWord {
+	var words []parseWord
+	start := -1
+	numeral := false
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		text := str[start:end]
+		if numeral {
+			text = strings.TrimRight(text, ",")
+		}
+		if text != "" {
+			words = append(words, parseWord{text: text, offset: start})
+		}
+		start = -1
+	}
+	for i, c := range str {
+		switch {
+		case unicode.IsSpace(c):
+			flush(i)
+		case numeral:
+		case c == '-' && start < 0:
+			// sign of a numeral, or a misplaced hyphen
+			start = i
+			numeral = true
+		case c == '-' || c == ',':
+			flush(i)
+		case start < 0:
+			start = i
+			_, numeral = numstr.DigitValue(c)
+		}
+		if start < 0 {
+			numeral = false
+		}
+	}
+	flush(len(str))
+	return words
+}
+
+// parseKind is the kind of the previous word in parser
+type parseKind uint8
+
+const (
+	parseNone    parseKind = iota
+	parseUnit              // One ... Nine
+	parseTeen              // Ten ... Nineteen
+	parseTens              // Twenty ... Ninety
+	parseHundred           // Hundred, Dozen
+	parseScale             // Thousand, Million, Lakh, ...
+	parseNumeral           // 25, 1.5
+	parseA                 // A, An
+	parseAnd               // And
+)
+
+// parseGroup is a value ending with a scale word, like "Two Million"
+type parseGroup struct {
+	value *big.Rat
+	exp   int
+}
+
+// parser reads number words one by one
+type parser struct {
+	input  string
+	o      *num2words.Options
+	groups []parseGroup
+	cur    *big.Rat // value of words after the last scale word
+	prev   parseKind
+	zero   bool
+}
+
+func (p *parser) wordError(w parseWord) error {
+	return &num2words.WordError{
+		Input:  p.input,
+		Offset: w.offset,
+		Word:   w.text,
+	}
+}
+
+// addSmall adds a number below 100 given by a word
+func (p *parser) addSmall(w parseWord, n uint16) error {
+	kind := parseUnit
+	switch {
+	case n >= 20:
+		kind = parseTens
+	case n >= 10:
+		kind = parseTeen
+	}
+	switch p.prev {
+	case parseNone, parseHundred, parseScale, parseAnd:
+	case parseTens:
+		// "Twenty One"
+		if kind != parseUnit {
+			return p.wordError(w)
+		}
+	default:
+		return p.wordError(w)
+	}
+	p.cur.Add(p.cur, new(big.Rat).SetInt64(int64(n)))
+	p.prev = kind
+	return nil
+}
+
+// addNumeral adds a number written with digits, like "2" in "2 Million"
+func (p *parser) addNumeral(w parseWord, r *big.Rat) error {
+	switch p.prev {
+	case parseNone, parseHundred, parseScale, parseAnd:
+	default:
+		return p.wordError(w)
+	}
+	p.cur.Add(p.cur, r)
+	p.prev = parseNumeral
+	return nil
+}
+
+// multiply multiplies the current value by n, for "Hundred" and "Dozen"
+func (p *parser) multiply(w parseWord, n int64) error {
+	switch p.prev {
+	case parseNone, parseA:
+		p.cur.SetInt64(n)
+	case parseUnit, parseTeen, parseTens, parseNumeral:
+		if n == 100 && p.cur.Cmp(big.NewRat(100, 1)) >= 0 {
+			// "One Hundred Five Hundred"
+			return p.wordError(w)
+		}
+		p.cur.Mul(p.cur, new(big.Rat).SetInt64(n))
+	default:
+		return p.wordError(w)
+	}
+	p.prev = parseHundred
+	return nil
+}
+
+// indianTop returns true if 10^exp is the highest Indian scale word of
+// options (Crore, or Kharab with num2words.ScaleIndianArab), which counts
+// higher numbers: "Two Crore, Three Crore" is (2 Crore + 3) Crore
+func (p *parser) indianTop(exp int) bool {
+	return exp == 3+2*indianTop(p.o)
+}
+
+// longThousand returns true if the last group is thousands of scale word
+// 10^exp in long scale, like "Five Thousand" in "Five Thousand Two
+// Hundred Billion"
+func (p *parser) longThousand(lower int, exp int) bool {
+	switch p.o.Scale {
+	case num2words.ScaleLong, num2words.ScalePeletier:
+	default:
+		return false
+	}
+	return lower == 1 && p.groups[len(p.groups)-1].exp == 3 && exp >= 6 && exp%6 == 0
+}
+
+// addScale ends the current value with scale word 10^exp
+// Lower groups before it are multiplied too, only for the highest Indian
+// word and thousands in long scale: "One Lakh Crore", "Five Thousand Two
+// Hundred Billion"
+func (p *parser) addScale(w parseWord, exp int) error {
+	switch p.prev {
+	case parseNone, parseA:
+		p.cur.SetInt64(1)
+	case parseUnit, parseTeen, parseTens, parseHundred, parseNumeral, parseScale:
+	default:
+		return p.wordError(w)
+	}
+	lower := 0
+	for lower < len(p.groups) && p.groups[len(p.groups)-1-lower].exp <= exp {
+		lower++
+	}
+	switch {
+	case lower > 0 && !p.indianTop(exp) && !p.longThousand(lower, exp):
+		// "One Thousand Five Thousand", "Two Million Three Billion"
+		return p.wordError(w)
+	case lower == 0 && p.prev == parseScale:
+		// "One Million Thousand"
+		return p.wordError(w)
+	}
+	sum := p.cur
+	for range lower {
+		sum.Add(sum, p.groups[len(p.groups)-1].value)
+		p.groups = p.groups[:len(p.groups)-1]
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+	p.groups = append(p.groups, parseGroup{
+		value: sum.Mul(sum, new(big.Rat).SetInt(scale)),
+		exp:   exp,
+	})
+	p.cur = new(big.Rat)
+	p.prev = parseScale
+	return nil
+}
+
+// add parses one lower case word, which is cardinal
+func (p *parser) add(w parseWord, word string) error {
+	if p.zero {
+		return p.wordError(w)
+	}
+	if n, ok := cardinal_words[word]; ok {
+		if n == 0 {
+			if p.prev != parseNone {
+				return p.wordError(w)
+			}
+			p.zero = true
+			return nil
+		}
+		return p.addSmall(w, n)
+	}
+	switch word {
+	case "a", "an":
+		if p.prev != parseNone && p.prev != parseScale && p.prev != parseAnd {
+			return p.wordError(w)
+		}
+		p.prev = parseA
+		return nil
+	case "and":
+		switch p.prev {
+		case parseHundred, parseScale:
+		default:
+			return p.wordError(w)
+		}
+		p.prev = parseAnd
+		return nil
+	case "hundred":
+		return p.multiply(w, 100)
+	case "dozen":
+		return p.multiply(w, 12)
+	}
+	if exp, ok := scaleExponent(word, p.o); ok {
+		return p.addScale(w, exp)
+	}
+	return p.wordError(w)
+}
+
+// parseNumeralWord parses a word with digits like "1,500", "2.5" or
+// "21st", and returns true as ordinal for the last one
+func parseNumeralWord(text string) (r *big.Rat, ordinal bool, ok bool) {
+	lower := strings.ToLower(text)
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		if digits, ok := strings.CutSuffix(lower, suffix); ok {
+			n, err := numstr.Parse(digits, false)
+			if err != nil || n.Negative || ordinalSuffix(n.Digits) != suffix {
+				return nil, false, false
+			}
+			r, _ := new(big.Rat).SetString(n.Digits)
+			return r, true, true
+		}
+	}
+	d, err := parseDecimal(text, false)
+	if err != nil {
+		return nil, false, false
+	}
+	r, _ = new(big.Rat).SetString(d.integer + "." + d.fraction + "0")
+	if d.negative {
+		r.Neg(r)
+	}
+	return r, false, true
+}
+
+// Parse reads a number written in english words, like the output of
+// ConvertString, and common variants of it: "Two Hundred and Thirty-Five
+// Thousand", "a hundred", "two dozen", "2 Million", "1.5 Billion"
+// The last word may be ordinal: "Twenty First", "21st"
+// Separators of numerals must be between groups of digits: "1,500" or
+// "12,34,567"
+// Scale words are read by num2words.WithScale, and Indian scale words
+// are always accepted. Words are not case sensitive
+// Returns *num2words.WordError for words that are not expected
+func Parse(str string, opts ...num2words.Option) (*big.Int, error) {
+	o := newOptions(opts)
+	words := splitWords(str)
+	if len(words) == 0 {
+		return nil, num2words.ErrEmpty
+	}
+	p := &parser{
+		input: str,
+		o:     o,
+		cur:   new(big.Rat),
+	}
+	negative := false
+	var fraction *parseWord // last numeral with fractional digits
+	for i, w := range words {
+		last := i == len(words)-1
+		if _, ok := numstr.DigitValue([]rune(w.text)[0]); ok || w.text[0] == '-' {
+			r, ordinal, ok := parseNumeralWord(w.text)
+			if !ok || r.Sign() < 0 && i > 0 || ordinal && !last || p.zero {
+				return nil, p.wordError(w)
+			}
+			if r.Sign() < 0 {
+				negative = true
+				r.Neg(r)
+			}
+			if !r.IsInt() {
+				fraction = &words[i]
+			}
+			err := p.addNumeral(w, r)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		word := strings.ToLower(w.text)
+		if i == 0 && !last && (word == "negative" || word == "minus") {
+			negative = true
+			continue
+		}
+		if last {
+			if _, ok := cardinal_words[word]; !ok {
+				if cardinal, ok := cardinalOf(word); ok {
+					word = cardinal
+				}
+			}
+		}
+		err := p.add(w, word)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch p.prev {
+	case parseA, parseAnd:
+		return nil, p.wordError(words[len(words)-1])
+	}
+	sum := p.cur
+	for _, g := range p.groups {
+		sum.Add(sum, g.value)
+	}
+	if !sum.IsInt() {
+		// "1.2345 Thousand"
+		return nil, p.wordError(*fraction)
+	}
+	n := new(big.Int).Set(sum.Num())
+	if negative {
+		n.Neg(n)
+	}
+	return n, nil
+}
@@ -0,0 +1,194 @@
+package english_test
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestParse(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		n, err := english.Parse(str, opts...)
+		if !is.Msg("str=%#v", str).NotErr(err) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(n.String(), expected)
+	}
+	test("Zero", "0")
+	test("zero", "0")
+	test("Five", "5")
+	test("Nineteen", "19")
+	test("Twenty One", "21")
+	test("twenty-one", "21")
+	test("One Hundred Five", "105")
+	test("one hundred and five", "105")
+	test("a hundred", "100")
+	test("an hundred and one", "101")
+	test("twelve hundred", "1200")
+	test("Nineteen Hundred", "1900")
+	test("a dozen", "12")
+	test("two dozen", "24")
+	test("One Thousand, Five", "1005")
+	test("One Thousand and Five", "1005")
+	test("two hundred and thirty-five thousand", "235000")
+	test("TWO HUNDRED THIRTY FIVE THOUSAND", "235000")
+	test("a million", "1000000")
+	test("one million two hundred thousand", "1200000")
+	test("2 million", "2000000")
+	test("1,500 thousand", "1500000")
+	test("12,34,567", "1234567")
+	test("1_000_000", "1000000")
+	test("2,500.5 thousand", "2500500")
+	test("1.5 Billion", "1500000000")
+	test("3 hundred", "300")
+	test("one million 5", "1000005")
+	test("12345", "12345")
+	test("Negative Twenty", "-20")
+	test("minus five thousand", "-5000")
+	test("-3 million", "-3000000")
+	test("  one   hundred  ", "100")
+
+	// ordinals
+	test("First", "1")
+	test("Twenty First", "21")
+	test("twenty-second", "22")
+	test("Thirtieth", "30")
+	test("one hundred and third", "103")
+	test("One Thousand, Twelfth", "1012")
+	test("Hundredth", "100")
+	test("One Millionth", "1000000")
+	test("Zeroth", "0")
+	test("21st", "21")
+	test("1,003rd", "1003")
+	test("2 millionth", "2000000")
+
+	// scales
+	test("One Thousand Million", "1000000000", num2words.WithScale(num2words.ScaleLong))
+	test("One Thousand One Million", "1001000000", num2words.WithScale(num2words.ScaleLong))
+	test("One Billion", "1000000000")
+	test("One Billion", "1000000000000", num2words.WithScale(num2words.ScaleLong))
+	test("One Milliard", "1000000000")
+	test("Five Thousand Two Hundred Billion", "5200000000000000", num2words.WithScale(num2words.ScaleLong))
+	test("One Billiard", "1000000000000000", num2words.WithScale(num2words.ScalePeletier))
+	test("One Vigintillion", "1"+zeros(63))
+	test("One Centillion", "1"+zeros(303))
+	test("One Lakh", "100000")
+	test("One Crore, Fifty Lakh", "15000000")
+	test("One Lakh Crore", "1000000000000")
+	test("One Crore Crore", "100000000000000")
+	test("One Hundred Kharab", "10000000000000")
+	test("Two Crore, Three Crore", "200000030000000", num2words.WithScale(num2words.ScaleIndian))
+}
+
+func zeros(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = '0'
+	}
+	return string(b)
+}
+
+func TestParseError(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, offset int, word string) {
+		_, err := english.Parse(str)
+		is.Msg("str=%#v", str).True(errors.Is(err, num2words.ErrInvalidWord))
+		var wordErr *num2words.WordError
+		if !is.Msg("str=%#v", str).True(errors.As(err, &wordErr)) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(wordErr.Input, str)
+		is.Msg("str=%#v", str).Equal(wordErr.Offset, offset)
+		is.Msg("str=%#v", str).Equal(wordErr.Word, word)
+	}
+	test("one hundred fivety", 12, "fivety")
+	test("apple", 0, "apple")
+	test("five five", 5, "five")
+	test("twenty twelve", 7, "twelve")
+	test("twenty thirty", 7, "thirty")
+	test("twelve three", 7, "three")
+	test("one hundred five hundred", 17, "hundred")
+	test("one thousand five thousand", 18, "thousand")
+	test("and five", 0, "and")
+	test("one hundred and", 12, "and")
+	test("one and five", 4, "and")
+	test("a", 0, "a")
+	test("a five", 2, "five")
+	test("zero five", 5, "five")
+	test("five zero", 5, "zero")
+	test("first hundred", 0, "first")
+	test("21st thousand", 0, "21st")
+	test("21nd", 0, "21nd")
+	test("five -3", 5, "-3")
+	test("1.2345 thousand", 0, "1.2345")
+	test("one, two", 5, "two")
+	test("five negative", 5, "negative")
+	test("two million three billion", 18, "billion")
+	test("one thousand one million", 17, "million")
+	test("One Thousand Million", 13, "Million")
+	test("one million thousand", 12, "thousand")
+	test("one lakh two lakh", 13, "lakh")
+	test("1,5", 0, "1,5")
+	test("1,5000", 0, "1,5000")
+	test("1234,567", 0, "1234,567")
+	test("1,00,000,000", 0, "1,00,000,000")
+
+	_, err := english.Parse("")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+	_, err = english.Parse(" - , ")
+	is.Err(err)
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	is := is.New(t).Lax()
+	rng := rand.New(rand.NewSource(1))
+	optionSets := [][]num2words.Option{
+		nil,
+		{num2words.WithHyphenation(true)},
+		{num2words.WithBritishAnd(true)},
+		{num2words.WithCase(num2words.CaseLower)},
+		{num2words.WithCase(num2words.CaseUpper), num2words.WithGroupSeparator(" ")},
+		{num2words.WithScale(num2words.ScaleLong)},
+		{num2words.WithScale(num2words.ScalePeletier)},
+		{num2words.WithScale(num2words.ScaleIndian)},
+		{num2words.WithScale(num2words.ScaleIndianArab)},
+	}
+	for range 300 {
+		digits := make([]byte, 1+rng.Intn(40))
+		for i := range digits {
+			digits[i] = byte('0' + rng.Intn(10))
+		}
+		if rng.Intn(2) == 0 {
+			// many zero groups
+			for i := range digits {
+				if rng.Intn(3) > 0 {
+					digits[i] = '0'
+				}
+			}
+		}
+		bn, _ := new(big.Int).SetString(string(digits), 10)
+		if rng.Intn(4) == 0 {
+			bn.Neg(bn)
+		}
+		for _, opts := range optionSets {
+			words := english.ConvertBigIntSigned(bn, opts...)
+			n, err := english.Parse(words, opts...)
+			if !is.Msg("words=%#v", words).NotErr(err) {
+				continue
+			}
+			is.Msg("words=%#v", words).Equal(n.String(), bn.String())
+			ordinal := english.ConvertOrdinalBigInt(new(big.Int).Abs(bn), opts...)
+			n, err = english.Parse(ordinal, opts...)
+			if !is.Msg("words=%#v", ordinal).NotErr(err) {
+				continue
+			}
+			is.Msg("words=%#v", ordinal).Equal(n.String(), new(big.Int).Abs(bn).String())
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package english
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ilius/num2words"
+)
+
+// roundScaled returns r * 10^digits rounded to an integer with mode
+func roundScaled(r *big.Rat, digits int, mode num2words.Rounding) *big.Int {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	num := new(big.Int).Mul(r.Num(), scale)
+	den := r.Denom()
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return q
+	}
+	away := false
+	switch mode {
+	case num2words.RoundDown:
+	case num2words.RoundUp:
+		away = true
+	default:
+		// compare the remainder with half of denominator
+		cmp := new(big.Int).Lsh(new(big.Int).Abs(rem), 1).Cmp(den)
+		away = cmp > 0 || cmp == 0 && (mode == num2words.RoundHalfUp || q.Bit(0) == 1)
+	}
+	if away {
+		if r.Sign() < 0 {
+			q.Sub(q, big_one)
+		} else {
+			q.Add(q, big_one)
+		}
+	}
+	return q
+}
+
+// splitScaled splits absolute value of n (a number multiplied by 10^digits)
+// into integer and fractional digits
+func splitScaled(n *big.Int, digits int) (string, string) {
+	str := new(big.Int).Abs(n).String()
+	if len(str) <= digits {
+		str = strings.Repeat("0", digits-len(str)+1) + str
+	}
+	return str[:len(str)-digits], str[len(str)-digits:]
+}
@@ -0,0 +1,141 @@
+package english
+
+import (
+	"strings"
+
+	"github.com/ilius/num2words"
+)
+
+// Latin prefixes of Conway-Wechsler system, for n < 10
+var cw_small = []string{
+	"ni", "mi", "bi", "tri", "quadri", "quinti", "sexti", "septi", "octi", "noni",
+}
+
+var cw_units = []string{
+	"", "un", "duo", "tre", "quattuor", "quin", "se", "septe", "octo", "nove",
+}
+
+// a prefix of tens or hundreds, with the marks that change the units
+// prefix before it (for example "tre" becomes "tres" before "S")
+type cwPrefix struct {
+	word  string
+	marks string
+}
+
+var cw_tens = []cwPrefix{
+	{"", ""},
+	{"deci", "N"},
+	{"viginti", "MS"},
+	{"triginta", "NS"},
+	{"quadraginta", "NS"},
+	{"quinquaginta", "NS"},
+	{"sexaginta", "N"},
+	{"septuaginta", "N"},
+	{"octoginta", "MX"},
+	{"nonaginta", ""},
+}
+
+var cw_hundreds = []cwPrefix{
+	{"", ""},
+	{"centi", "NX"},
+	{"ducenti", "N"},
+	{"trecenti", "NS"},
+	{"quadringenti", "NS"},
+	{"quingenti", "NS"},
+	{"sescenti", "N"},
+	{"septingenti", "N"},
+	{"octingenti", "MX"},
+	{"nongenti", ""},
+}
+
+// cwUnits returns the units prefix, changed by marks of the next prefix
+func cwUnits(units int, marks string) string {
+	word := cw_units[units]
+	has := func(mark string) bool {
+		return strings.Contains(marks, mark)
+	}
+	switch units {
+	case 3:
+		if has("S") || has("X") {
+			return word + "s"
+		}
+	case 6:
+		if has("X") {
+			return word + "x"
+		}
+		if has("S") {
+			return word + "s"
+		}
+	case 7, 9:
+		if has("M") {
+			return word + "m"
+		}
+		if has("N") {
+			return word + "n"
+		}
+	}
+	return word
+}
+
+// cwChunk returns the Latin prefix of 0 <= n < 1000 followed by "illi"
+func cwChunk(n int) string {
+	var prefix string
+	if n < 10 {
+		prefix = cw_small[n]
+	} else {
+		tens := cw_tens[n/10%10]
+		hundreds := cw_hundreds[n/100]
+		marks := tens.marks
+		if tens.word == "" {
+			marks = hundreds.marks
+		}
+		prefix = cwUnits(n%10, marks) + tens.word + hundreds.word
+	}
+	// the final vowel is dropped before "illi"
+	return prefix[:len(prefix)-1] + "illi"
+}
+
+// scaleWord returns the short scale name of 1000^i, for i >= 1
+// For i >= len(big_words), the name of 10^(3n+3) with n = i-1 is made by
+// the Conway-Wechsler system, for example "Unvigintillion" and
+// "Millinillion"
+func scaleWord(i int) string {
+	if i < len(big_words) {
+		return big_words[i]
+	}
+	chunks := []int{}
+	for n := i - 1; n > 0; n /= 1000 {
+		chunks = append(chunks, n%1000)
+	}
+	var sb strings.Builder
+	for k := len(chunks) - 1; k >= 0; k-- {
+		sb.WriteString(cwChunk(chunks[k]))
+	}
+	sb.WriteString("on")
+	word := sb.String()
+	return strings.ToUpper(word[:1]) + word[1:]
+}
+
+// longThousand returns true if group index i is read as thousands of the
+// scale word of group i-1, like "Five Thousand Billion" in long scale
+func longThousand(i int, o *num2words.Options) bool {
+	return o.Scale == num2words.ScaleLong && i >= 5 && i%2 == 1
+}
+
+// orderWord returns the name of 1000^i in the scale of o, for i > 0
+// If longThousand(i, o), it returns "Thousand"
+func orderWord(i int, o *num2words.Options) string {
+	switch o.Scale {
+	case num2words.ScaleLong, num2words.ScalePeletier:
+		if i == 1 || longThousand(i, o) {
+			return big_words[1]
+		}
+		if i%2 == 0 {
+			// 10^(6n) is the short scale name of 10^(3n+3)
+			return scaleWord(i/2 + 1)
+		}
+		// 10^(6n+3): Milliard, Billiard, ...
+		return strings.TrimSuffix(scaleWord(i/2+1), "on") + "ard"
+	}
+	return scaleWord(i)
+}
@@ -0,0 +1,143 @@
+package english_test
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
+
+func TestConvertBigIntShortScale(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(exp int64, expected string) {
+		is.Msg("exp=%v", exp).Equal(english.ConvertBigInt(pow10(exp)), expected)
+	}
+	test(9, "One Billion")
+	test(12, "One Trillion")
+	test(15, "One Quadrillion")
+	test(18, "One Quintillion")
+	test(21, "One Sextillion")
+	test(33, "One Decillion")
+	test(45, "One Quattuordecillion")
+	test(51, "One Sexdecillion")
+	test(60, "One Novemdecillion")
+	test(63, "One Vigintillion")
+	test(64, "Ten Vigintillion")
+	test(66, "One Unvigintillion")
+	test(72, "One Tresvigintillion")
+	test(84, "One Septemvigintillion")
+	test(90, "One Novemvigintillion")
+	test(93, "One Trigintillion")
+	test(111, "One Sestrigintillion")
+	test(303, "One Centillion")
+	test(306, "One Uncentillion")
+	test(312, "One Trescentillion")
+	test(321, "One Sexcentillion")
+	test(363, "One Viginticentillion")
+	test(603, "One Ducentillion")
+	test(2403, "One Octingentillion")
+	test(3003, "One Millinillion")
+	test(3006, "One Millimillion")
+	test(3009, "One Millibillion")
+	test(6006, "One Billimillion")
+
+	is.Equal(
+		english.ConvertBigInt(big.NewInt(0).Add(pow10(12), pow10(9))),
+		"One Trillion, One Billion",
+	)
+	is.Equal(
+		english.ConvertBigInt(big.NewInt(0).Mul(big.NewInt(999), pow10(63))),
+		"Nine Hundred Ninety Nine Vigintillion",
+	)
+}
+
+func TestConvertStringShortScale(t *testing.T) {
+	is := is.New(t)
+	words, err := english.ConvertString("1" + strings.Repeat("0", 15))
+	is.NotErr(err)
+	is.Equal(words, "One Quadrillion")
+	words, err = english.ConvertString("2,000,000,000,000,000,000,003")
+	is.NotErr(err)
+	is.Equal(words, "Two Sextillion, Three")
+}
+
+func TestConvertBigIntLongScale(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(scale num2words.Scale, bn *big.Int, expected string) {
+		opt := num2words.WithScale(scale)
+		is.Msg("scale=%v, num=%v", scale, bn).Equal(english.ConvertBigInt(bn, opt), expected)
+		words, err := english.ConvertString(bn.String(), opt)
+		is.NotErr(err)
+		is.Msg("scale=%v, num=%v", scale, bn).Equal(words, expected)
+		buf := bytes.NewBuffer(nil)
+		err = english.ConvertReader(buf, strings.NewReader(bn.String()), opt)
+		is.NotErr(err)
+		is.Msg("scale=%v, num=%v", scale, bn).Equal(buf.String(), expected)
+		neg := new(big.Int).Neg(bn)
+		is.Msg("scale=%v, num=%v", scale, neg).Equal(
+			english.ConvertBigIntSigned(neg, opt),
+			"Negative "+expected,
+		)
+	}
+	sum := func(nums ...*big.Int) *big.Int {
+		bn := big.NewInt(0)
+		for _, n := range nums {
+			bn.Add(bn, n)
+		}
+		return bn
+	}
+	mul := func(n int64, bn *big.Int) *big.Int {
+		return new(big.Int).Mul(big.NewInt(n), bn)
+	}
+
+	long := num2words.ScaleLong
+	test(long, big.NewInt(1500), "One Thousand, Five Hundred")
+	test(long, pow10(6), "One Million")
+	test(long, pow10(9), "One Milliard")
+	test(long, pow10(12), "One Billion")
+	test(long, pow10(15), "One Thousand Billion")
+	test(long, pow10(18), "One Trillion")
+	test(long, pow10(21), "One Thousand Trillion")
+	test(long, pow10(24), "One Quadrillion")
+	test(long, pow10(120), "One Vigintillion")
+	test(long, pow10(126), "One Unvigintillion")
+	test(long, mul(5, pow10(15)), "Five Thousand Billion")
+	test(
+		long,
+		sum(mul(123456, pow10(12)), big.NewInt(7)),
+		"One Hundred Twenty Three Thousand Four Hundred Fifty Six Billion, Seven",
+	)
+	test(
+		long,
+		sum(mul(2, pow10(12)), mul(3, pow10(9)), mul(4, pow10(6))),
+		"Two Billion, Three Milliard, Four Million",
+	)
+	test(
+		long,
+		sum(pow10(21), mul(20, pow10(18)), pow10(15), pow10(3)),
+		"One Thousand Twenty Trillion, One Thousand Billion, One Thousand",
+	)
+
+	peletier := num2words.ScalePeletier
+	test(peletier, pow10(9), "One Milliard")
+	test(peletier, pow10(12), "One Billion")
+	test(peletier, pow10(15), "One Billiard")
+	test(peletier, pow10(18), "One Trillion")
+	test(peletier, pow10(21), "One Trilliard")
+	test(peletier, pow10(123), "One Vigintilliard")
+	test(
+		peletier,
+		sum(mul(123456, pow10(12)), big.NewInt(7)),
+		"One Hundred Twenty Three Billiard, Four Hundred Fifty Six Billion, Seven",
+	)
+
+	test(num2words.ScaleShort, pow10(15), "One Quadrillion")
+}
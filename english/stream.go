@@ -0,0 +1,58 @@
+package english
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/stream"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+// ConvertReader reads digits of a non-negative integer from r and writes
+// words to w, group by group, most significant first.
+// White space between digits is ignored. Memory usage does not depend on
+// the number of digits, if r can not seek, it is copied to a temporary file.
+func ConvertReader(w io.Writer, r io.Reader, opts ...num2words.Option) error {
+	o := newOptions(opts)
+	sw := stream.NewWriter(w, o)
+	if isIndian(o) {
+		return fmt.Errorf("english: ConvertReader: Indian scale: %w", num2words.ErrUnsupported)
+	}
+	check := func(info *stream.Info) error {
+		if info.Digits == 0 {
+			return sw.WriteString(en_zero)
+		}
+		return nil
+	}
+	thousand := false // last group was read with longThousand
+	err := stream.Groups(r, check, func(i int, p uint16) error {
+		if thousand {
+			thousand = false
+			l := &tokens.List{}
+			if p != 0 {
+				l.Space(" ")
+				addSmall(l, p, i, o)
+			}
+			l.Space(" ")
+			addOrder(l, i, o)
+			return sw.WriteString(l.String())
+		}
+		if p == 0 {
+			return nil
+		}
+		thousand = longThousand(i, o)
+		if sw.Started() {
+			if err := sw.WriteString(groupSeparator(p, i, o)); err != nil {
+				return err
+			}
+		}
+		l := &tokens.List{}
+		addGroup(l, p, i, o)
+		return sw.WriteString(l.String())
+	})
+	if err != nil {
+		return err
+	}
+	return sw.Flush()
+}
@@ -0,0 +1,59 @@
+package english_test
+
+import (
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func convertReader(t *testing.T, r io.Reader, opts ...num2words.Option) string {
+	t.Helper()
+	buf := &strings.Builder{}
+	err := english.ConvertReader(buf, r, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestConvertReader(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		is.Msg("num=%v", tc.String).Equal(
+			convertReader(t, strings.NewReader(tc.String)),
+			tc.Words,
+		)
+	}
+	is.Equal(
+		convertReader(t, struct{ io.Reader }{strings.NewReader("0001002\n")}),
+		english.ConvertBigInt(big.NewInt(1002)),
+	)
+}
+
+func TestConvertReaderLarge(t *testing.T) {
+	is := is.New(t).Lax()
+	str := strings.Repeat("9876543210", 300/10) + "123"
+	bn := &big.Int{}
+	bn.SetString(str, 10)
+	is.Equal(
+		convertReader(t, strings.NewReader(str)),
+		english.ConvertBigInt(bn),
+	)
+	is.Equal(
+		convertReader(t, strings.NewReader(str), num2words.WithCase(num2words.CaseUpper)),
+		english.ConvertBigInt(bn, num2words.WithCase(num2words.CaseUpper)),
+	)
+}
+
+func TestConvertReaderSentenceCase(t *testing.T) {
+	is := is.New(t)
+	is.Equal(
+		convertReader(t, strings.NewReader("1001"), num2words.WithCase(num2words.CaseSentence)),
+		"One thousand, one",
+	)
+}
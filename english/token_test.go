@@ -0,0 +1,68 @@
+package english_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertStringTokens(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		tokens, err := english.ConvertStringTokens(tc.String)
+		is.NotErr(err)
+		is.Msg("num=%v", tc.String).Equal(num2words.JoinTokens(tokens), tc.Words)
+	}
+}
+
+func TestConvertBigIntTokens(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		tokens := english.ConvertBigIntTokens(tc.BigInt)
+		is.Msg("num=%v", tc.String).Equal(num2words.JoinTokens(tokens), tc.Words)
+	}
+	tokens := english.ConvertBigIntTokens(big.NewInt(-5))
+	is.Equal(num2words.JoinTokens(tokens), "Negative Five")
+	is.Equal(tokens[0].Kind, num2words.TokenSign)
+	is.Equal(tokens[0].Group, -1)
+}
+
+func TestConvertStringTokensKinds(t *testing.T) {
+	is := is.New(t)
+	tokens, err := english.ConvertStringTokens("2001315")
+	is.NotErr(err)
+	type kindGroup struct {
+		Kind  num2words.TokenKind
+		Text  string
+		Group int
+	}
+	actual := []kindGroup{}
+	for _, t := range tokens {
+		actual = append(actual, kindGroup{t.Kind, t.Text, t.Group})
+	}
+	is.Equal(actual, []kindGroup{
+		{num2words.TokenDigit, "Two", 2},
+		{num2words.TokenScale, "Million", 2},
+		{num2words.TokenConjunction, ",", 1},
+		{num2words.TokenDigit, "One", 1},
+		{num2words.TokenScale, "Thousand", 1},
+		{num2words.TokenConjunction, ",", 0},
+		{num2words.TokenDigit, "Three", 0},
+		{num2words.TokenHundred, "Hundred", 0},
+		{num2words.TokenTeen, "Fifteen", 0},
+	})
+}
+
+func TestConvertStringTokensCase(t *testing.T) {
+	is := is.New(t)
+	tokens, err := english.ConvertStringTokens(
+		"-1021",
+		num2words.WithCase(num2words.CaseSentence),
+	)
+	is.NotErr(err)
+	is.Equal(num2words.JoinTokens(tokens), "Negative one thousand, twenty one")
+	is.Equal(tokens[1].Text, "one")
+}
@@ -0,0 +1,204 @@
+package english
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+const (
+	en_oh = "Oh"
+	en_bc = "BC"
+)
+
+// era names accepted around years, and their normal form
+// Longer names come first, since "BCE" ends with "CE"
+var eras = [][2]string{
+	{"B.C.E.", "BCE"},
+	{"BCE", "BCE"},
+	{"C.E.", "CE"},
+	{"CE", "CE"},
+	{"B.C.", "BC"},
+	{"BC", "BC"},
+	{"A.D.", "AD"},
+	{"AD", "AD"},
+}
+
+// year is a parsed year string
+type year struct {
+	num     *numstr.Number
+	era     string // normal form of era, or empty
+	eraLast bool   // era comes after the number: "44 BC", not "AD 1066"
+}
+
+// cutEra removes era name from start or end of str, and returns the
+// offset of the rest in str
+func cutEra(str string) (rest string, offset int, era string, eraLast bool) {
+	upper := strings.ToUpper(str)
+	for _, e := range eras {
+		if strings.HasSuffix(upper, e[0]) {
+			rest := str[:len(str)-len(e[0])]
+			if rest == "" || isEraBoundary(rest[len(rest)-1]) {
+				return rest, 0, e[1], true
+			}
+		}
+		if strings.HasPrefix(upper, e[0]) {
+			rest := str[len(e[0]):]
+			if rest == "" || isEraBoundary(rest[0]) {
+				return rest, len(e[0]), e[1], false
+			}
+		}
+	}
+	return str, 0, "", false
+}
+
+func isEraBoundary(c byte) bool {
+	return c == ' ' || c >= '0' && c <= '9'
+}
+
+// parseYear parses year with optional era, like "1984", "44 BC" or
+// "AD 1066"
+func parseYear(str string, strict bool) (*year, error) {
+	trimmed := strings.TrimLeftFunc(str, unicode.IsSpace)
+	start := len(str) - len(trimmed)
+	trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+	rest, offset, era, eraLast := cutEra(trimmed)
+	offset += start
+	// space between number and era: "44 BC"
+	spaced := rest
+	rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	offset += len(spaced) - len(rest)
+	rest = strings.TrimRightFunc(rest, unicode.IsSpace)
+	n, err := numstr.Parse(rest, strict)
+	if err == nil && n.Negative && era != "" && n.Digits != "0" {
+		// "-44 BC"
+		err = n.SignError()
+	}
+	if err != nil {
+		// error offsets must point into str
+		var charErr *num2words.InvalidCharError
+		if errors.As(err, &charErr) {
+			return nil, &num2words.InvalidCharError{
+				Input:  str,
+				Offset: charErr.Offset + offset,
+				Char:   charErr.Char,
+			}
+		}
+		var signErr *num2words.SignError
+		if errors.As(err, &signErr) {
+			return nil, &num2words.SignError{
+				Input:  str,
+				Offset: signErr.Offset + offset,
+				Sign:   signErr.Sign,
+			}
+		}
+		return nil, err
+	}
+	return &year{
+		num:     n,
+		era:     era,
+		eraLast: eraLast,
+	}, nil
+}
+
+// addYear adds words of year with ASCII digits, without leading zeros
+//
+//	1984 => "Nineteen Eighty Four"
+//	1900 => "Nineteen Hundred"
+//	1905 => "Nineteen Oh Five"
+//	2005 => "Two Thousand Five"
+//	2024 => "Twenty Twenty Four"
+//
+// Years with other than 4 digits are read as cardinal numbers
+func addYear(l *tokens.List, digits string, o *num2words.Options) error {
+	if len(digits) != 4 {
+		return addDigits(l, digits, o)
+	}
+	y, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return err
+	}
+	hi := uint16(y / 100)
+	lo := uint16(y % 100)
+	switch {
+	case y%1000 < 10:
+		// "Two Thousand", "Two Thousand Five"
+		addSmall(l, hi/10, 1, o)
+		l.Space(" ")
+		addOrder(l, 1, o)
+		if lo != 0 {
+			l.Space(" ")
+			addSmall(l, lo, 0, o)
+		}
+		return nil
+	case lo == 0:
+		// "Nineteen Hundred"
+		addSmall(l, hi, 1, o)
+		l.Space(" ")
+		l.Add(num2words.TokenHundred, en_hundred, 0)
+		return nil
+	}
+	addSmall(l, hi, 1, o)
+	if lo < 10 {
+		// "Nineteen Oh Five"
+		l.Space(" ")
+		l.Add(num2words.TokenDigit, en_oh, 0)
+	}
+	l.Space(" ")
+	addSmall(l, lo, 0, o)
+	return nil
+}
+
+// ConvertYearStringTokens is like ConvertYearString, but returns tokens
+func ConvertYearStringTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	y, err := parseYear(str, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	era := y.era
+	if y.num.Negative && y.num.Digits != "0" {
+		era = en_bc
+		y.eraLast = true
+	}
+	l := &tokens.List{}
+	if era != "" && !y.eraLast {
+		l.Add(num2words.TokenQualifier, era, -1)
+		l.Space(" ")
+	}
+	err = addYear(l, y.num.Digits, o)
+	if err != nil {
+		return nil, err
+	}
+	if era != "" && y.eraLast {
+		l.Space(" ")
+		l.Add(num2words.TokenQualifier, era, -1)
+	}
+	return l.Result(o), nil
+}
+
+// ConvertYearString reads year like dates: "1984" => "Nineteen Eighty Four"
+// Era names BC, AD, BCE and CE (with or without dots) are accepted before
+// or after the number, and negative years are read with "BC":
+// "-44" => "Forty Four BC"
+func ConvertYearString(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertYearStringTokens(str, opts...)
+	if err != nil {
+		return "", err
+	}
+	return num2words.JoinTokens(result), nil
+}
+
+// ConvertYear is like ConvertYearString for year number
+func ConvertYear(year int, opts ...num2words.Option) string {
+	result, err := ConvertYearString(strconv.Itoa(year), opts...)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
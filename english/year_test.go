@@ -0,0 +1,109 @@
+package english_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/english"
+)
+
+func TestConvertYearString(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := english.ConvertYearString(str, opts...)
+		is.NotErr(err)
+		is.Msg("year=%v", str).Equal(words, expected)
+	}
+	test("1984", "Nineteen Eighty Four")
+	test("1984", "nineteen eighty-four",
+		num2words.WithCase(num2words.CaseLower),
+		num2words.WithHyphenation(true),
+	)
+	test("2005", "Two Thousand Five")
+	test("2000", "Two Thousand")
+	test("1000", "One Thousand")
+	test("1001", "One Thousand One")
+	test("2010", "Twenty Ten")
+	test("2024", "Twenty Twenty Four")
+	test("1900", "Nineteen Hundred")
+	test("2100", "Twenty One Hundred")
+	test("1105", "Eleven Oh Five")
+	test("1905", "Nineteen Oh Five")
+	test("1066", "Ten Sixty Six")
+	test("1776", "Seventeen Seventy Six")
+	test("476", "Four Hundred Seventy Six")
+	test("44", "Forty Four")
+	test("7", "Seven")
+	test("0", "Zero")
+	test("12345", "Twelve Thousand, Three Hundred Forty Five")
+
+	// eras
+	test("44 BC", "Forty Four BC")
+	test("44 b.c.", "Forty Four BC")
+	test("1984 AD", "Nineteen Eighty Four AD")
+	test("AD 1066", "AD Ten Sixty Six")
+	test("A.D. 1066", "AD Ten Sixty Six")
+	test("500 BCE", "Five Hundred BCE")
+	test("2024 CE", "Twenty Twenty Four CE")
+	test("2024CE", "Twenty Twenty Four CE")
+	test(" 1500 B.C.E. ", "Fifteen Hundred BCE")
+
+	// negative years
+	test("-44", "Forty Four BC")
+	test("-1200", "Twelve Hundred BC")
+	test("-0", "Zero")
+}
+
+func TestConvertYearStringErrors(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, target error, offset int) {
+		_, err := english.ConvertYearString(str)
+		is.Msg("year=%#v", str).True(errors.Is(err, target))
+		var charErr *num2words.InvalidCharError
+		if errors.As(err, &charErr) {
+			is.Msg("year=%#v", str).Equal(charErr.Offset, offset)
+			is.Msg("year=%#v", str).Equal(charErr.Input, str)
+		}
+		var signErr *num2words.SignError
+		if errors.As(err, &signErr) {
+			is.Msg("year=%#v", str).Equal(signErr.Offset, offset)
+			is.Msg("year=%#v", str).Equal(signErr.Input, str)
+		}
+	}
+	test("", num2words.ErrEmpty, 0)
+	test("BC", num2words.ErrEmpty, 0)
+	test("19x4", num2words.ErrInvalidChar, 2)
+	test("AD 19x4", num2words.ErrInvalidChar, 5)
+	test("1984 ACE", num2words.ErrInvalidChar, 5)
+	test("-44 BC", num2words.ErrUnsupportedSign, 0)
+	test(" BC -44", num2words.ErrUnsupportedSign, 4)
+}
+
+func TestConvertYearStringStrict(t *testing.T) {
+	is := is.New(t).Lax()
+	strict := num2words.WithStrict(true)
+	test := func(str string, expected string) {
+		words, err := english.ConvertYearString(str, strict)
+		is.NotErr(err)
+		is.Msg("year=%#v", str).Equal(words, expected)
+	}
+	test("1984", "Nineteen Eighty Four")
+	test("44 BC", "Forty Four BC")
+	test("AD 1066", "AD Ten Sixty Six")
+}
+
+func TestConvertYear(t *testing.T) {
+	is := is.New(t)
+	is.Equal(english.ConvertYear(1984), "Nineteen Eighty Four")
+	is.Equal(english.ConvertYear(-753), "Seven Hundred Fifty Three BC")
+	tokens, err := english.ConvertYearStringTokens("1905 AD")
+	is.NotErr(err)
+	texts := []string{}
+	for _, t := range tokens {
+		texts = append(texts, t.Text)
+	}
+	is.Equal(texts, []string{"Nineteen", "Oh", "Five", "AD"})
+	is.Equal(tokens[3].Kind, num2words.TokenQualifier)
+}
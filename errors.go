@@ -0,0 +1,116 @@
+package num2words
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnsupported is returned (wrapped) by Converter methods that are
+	// not supported by the language, see Converter.Capabilities
+	ErrUnsupported = errors.New("not supported by this language")
+
+	// ErrUnknownLanguage is returned (wrapped) by For when no Converter is
+	// registered for the given tag
+	ErrUnknownLanguage = errors.New("unknown language")
+
+	// ErrEmpty is returned when the input number string is empty
+	ErrEmpty = errors.New("num2words: empty number")
+
+	// ErrInvalidChar matches every *InvalidCharError with errors.Is
+	ErrInvalidChar = errors.New("num2words: invalid character")
+
+	// ErrTooLarge matches every *TooLargeError with errors.Is
+	ErrTooLarge = errors.New("num2words: number too large")
+
+	// ErrUnsupportedSign matches every *SignError with errors.Is
+	ErrUnsupportedSign = errors.New("num2words: unsupported sign")
+
+	// ErrZeroDenominator is returned for fractions with zero denominator
+	ErrZeroDenominator = errors.New("num2words: zero denominator")
+
+	// ErrInvalidWord matches every *WordError with errors.Is
+	ErrInvalidWord = errors.New("num2words: invalid word")
+)
+
+// InvalidCharError is returned when the input has a character that is
+// not a digit
+type InvalidCharError struct {
+	Input  string // empty if input was a stream
+	Offset int    // byte offset of Char in Input
+	Char   rune
+}
+
+func (e *InvalidCharError) Error() string {
+	if e.Input == "" { // from a stream
+		return fmt.Sprintf(
+			"num2words: invalid character %q at offset %d",
+			e.Char, e.Offset,
+		)
+	}
+	return fmt.Sprintf(
+		"num2words: invalid character %q at offset %d in %#v",
+		e.Char, e.Offset, e.Input,
+	)
+}
+
+func (e *InvalidCharError) Is(target error) bool {
+	return target == ErrInvalidChar
+}
+
+// TooLargeError is returned when the number has more digits than the
+// scale table of the language can name
+type TooLargeError struct {
+	Digits    int
+	MaxDigits int
+}
+
+func (e *TooLargeError) Error() string {
+	return fmt.Sprintf(
+		"num2words: number too large: %d digits, max %d digits",
+		e.Digits, e.MaxDigits,
+	)
+}
+
+func (e *TooLargeError) Is(target error) bool {
+	return target == ErrTooLarge
+}
+
+// SignError is returned when the input has a sign that is not supported
+// by the language or the called function (for example an ordinal of a
+// negative number)
+type SignError struct {
+	Input  string
+	Offset int // byte offset of Sign in Input
+	Sign   rune
+}
+
+func (e *SignError) Error() string {
+	return fmt.Sprintf(
+		"num2words: unsupported sign %q at offset %d in %#v",
+		e.Sign, e.Offset, e.Input,
+	)
+}
+
+func (e *SignError) Is(target error) bool {
+	return target == ErrUnsupportedSign
+}
+
+// WordError is returned by parsers of number words when a word of the
+// input is not a number word, or is not expected in its place
+type WordError struct {
+	Input  string
+	Offset int // byte offset of Word in Input
+	Word   string
+}
+
+func (e *WordError) Error() string {
+	return fmt.Sprintf(
+		"num2words: invalid word %q at offset %d in %#v",
+		e.Word, e.Offset, e.Input,
+	)
+}
+
+func (e *WordError) Is(target error) bool {
+	return target == ErrInvalidWord
+}
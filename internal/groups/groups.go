@@ -0,0 +1,98 @@
+// Package groups splits numbers into groups of 3 digits, shared by
+// language packages
+package groups
+
+import (
+	"math/big"
+
+	"github.com/ilius/num2words"
+)
+
+var big_thousand = big.NewInt(1000)
+
+// Abs returns the absolute value of n as uint64, and whether n is negative
+// Works for the minimum value of signed types, like math.MinInt64
+func Abs[T num2words.Integer](n T) (uint64, bool) {
+	if n >= 0 {
+		return uint64(n), false
+	}
+	// -(n+1) does not overflow
+	return uint64(-(n + 1)) + 1, true
+}
+
+// FromUint64 returns 3-digit groups of n, least significant first
+func FromUint64(n uint64) []uint16 {
+	groups := make([]uint16, 0, 7)
+	for {
+		groups = append(groups, uint16(n%1000))
+		n /= 1000
+		if n == 0 {
+			break
+		}
+	}
+	return groups
+}
+
+// numbers with up to baseGroups groups are split using uint64
+const baseGroups = 4 // 10^12 < 2^64
+
+// powers returns [1000^2, 1000^4, 1000^8, ...] up to the first power
+// whose square is greater than n
+func powers(n *big.Int) []*big.Int {
+	pow := new(big.Int).Mul(big_thousand, big_thousand)
+	list := []*big.Int{pow}
+	for {
+		// n < pow^2 if n has fewer bits than pow^2 has
+		if n.BitLen() < 2*pow.BitLen()-1 {
+			return list
+		}
+		pow = new(big.Int).Mul(pow, pow)
+		list = append(list, pow)
+	}
+}
+
+// split fills out with 3-digit groups of n (least significant first),
+// where n < 1000^len(out), len(out) = baseGroups * 2^k and
+// pows[k] = 1000^(len(out)/2)
+func split(out []uint16, n *big.Int, pows []*big.Int, k int) {
+	if len(out) <= baseGroups {
+		u := n.Uint64()
+		for i := range out {
+			out[i] = uint16(u % 1000)
+			u /= 1000
+		}
+		return
+	}
+	if n.Sign() == 0 {
+		clear(out)
+		return
+	}
+	half := len(out) / 2
+	hi, lo := new(big.Int).QuoRem(n, pows[k], new(big.Int))
+	split(out[:half], lo, pows, k-1)
+	split(out[half:], hi, pows, k-1)
+}
+
+// FromBigInt returns 3-digit groups of absolute value of bn, least
+// significant first
+// It splits the number recursively by 1000^(2^k), which is much faster
+// than dividing by 1000 repeatedly for numbers with many digits
+func FromBigInt(bn *big.Int) []uint16 {
+	if bn.IsUint64() {
+		return FromUint64(bn.Uint64())
+	}
+	n := new(big.Int).Abs(bn)
+	if n.IsUint64() {
+		return FromUint64(n.Uint64())
+	}
+	pows := powers(n)
+	k := len(pows)
+	// n < pows[k-1]^2 = 1000^(2^(k+1)) = 1000^(baseGroups * 2^(k-1))
+	out := make([]uint16, baseGroups<<(k-1))
+	split(out, n, pows, k-1)
+	end := len(out)
+	for end > 1 && out[end-1] == 0 {
+		end--
+	}
+	return out[:end]
+}
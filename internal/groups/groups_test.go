@@ -0,0 +1,127 @@
+package groups_test
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words/internal/groups"
+)
+
+func TestAbs(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(abs uint64, negative bool, expectedAbs uint64, expectedNegative bool) {
+		is.Equal(abs, expectedAbs)
+		is.Equal(negative, expectedNegative)
+	}
+	abs, neg := groups.Abs(0)
+	test(abs, neg, 0, false)
+	abs, neg = groups.Abs(-5)
+	test(abs, neg, 5, true)
+	abs, neg = groups.Abs(int8(math.MinInt8))
+	test(abs, neg, 128, true)
+	abs, neg = groups.Abs(int64(math.MinInt64))
+	test(abs, neg, 1<<63, true)
+	abs, neg = groups.Abs(int64(math.MaxInt64))
+	test(abs, neg, math.MaxInt64, false)
+	abs, neg = groups.Abs(uint64(math.MaxUint64))
+	test(abs, neg, math.MaxUint64, false)
+}
+
+func TestFromUint64(t *testing.T) {
+	is := is.New(t).Lax()
+	is.Equal(groups.FromUint64(0), []uint16{0})
+	is.Equal(groups.FromUint64(999), []uint16{999})
+	is.Equal(groups.FromUint64(1000), []uint16{0, 1})
+	is.Equal(groups.FromUint64(1234567), []uint16{567, 234, 1})
+	is.Equal(
+		groups.FromUint64(math.MaxUint64),
+		[]uint16{615, 551, 709, 73, 744, 446, 18},
+	)
+}
+
+// slow and simple
+func fromBigIntByDiv(bn *big.Int) []uint16 {
+	n := new(big.Int).Abs(bn)
+	groups := []uint16{}
+	mod := &big.Int{}
+	for {
+		n.DivMod(n, big.NewInt(1000), mod)
+		groups = append(groups, uint16(mod.Uint64()))
+		if n.Sign() == 0 {
+			return groups
+		}
+	}
+}
+
+func TestFromBigInt(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, parts []uint16) {
+		bn := &big.Int{}
+		_, ok := bn.SetString(str, 10)
+		if !ok {
+			t.Fatalf("failed to parse %v as big int", str)
+		}
+		is.Msg("num=%v", str).Equal(groups.FromBigInt(bn), parts)
+	}
+	test("0", []uint16{0})
+	test("1", []uint16{1})
+	test("123", []uint16{123})
+	test("1234", []uint16{234, 1})
+	test("12345", []uint16{345, 12})
+	test("123456", []uint16{456, 123})
+	test("1234567", []uint16{567, 234, 1})
+	test("-1234567", []uint16{567, 234, 1})
+	test("18446744073709551616", []uint16{616, 551, 709, 73, 744, 446, 18})
+	test("1"+strings.Repeat("000", 40), append(make([]uint16, 40), 1))
+	test("-1"+strings.Repeat("000", 40), append(make([]uint16, 40), 1))
+
+	for digits := 19; digits < 2000; digits += 37 {
+		bn := &big.Int{}
+		bn.SetString(strings.Repeat("9876543210", digits/10+1)[:digits], 10)
+		is.Msg("digits=%v", digits).Equal(groups.FromBigInt(bn), fromBigIntByDiv(bn))
+		bn.Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+		is.Msg("10^%v", digits).Equal(groups.FromBigInt(bn), fromBigIntByDiv(bn))
+		bn.Sub(bn, big.NewInt(1))
+		is.Msg("10^%v-1", digits).Equal(groups.FromBigInt(bn), fromBigIntByDiv(bn))
+	}
+}
+
+func benchmarkFromBigInt(b *testing.B, digits int, fromBigInt func(*big.Int) []uint16) {
+	bn := &big.Int{}
+	bn.SetString(strings.Repeat("9876543210", digits/10), 10)
+	b.ResetTimer()
+	for range b.N {
+		_ = fromBigInt(bn)
+	}
+}
+
+func BenchmarkFromBigInt(b *testing.B) {
+	b.Run("1e3", func(b *testing.B) {
+		benchmarkFromBigInt(b, 1000, groups.FromBigInt)
+	})
+	b.Run("1e5", func(b *testing.B) {
+		benchmarkFromBigInt(b, 100_000, groups.FromBigInt)
+	})
+	b.Run("1e6", func(b *testing.B) {
+		benchmarkFromBigInt(b, 1_000_000, groups.FromBigInt)
+	})
+	// repeated division by 1000, for comparison (too slow for 1e6)
+	b.Run("div-1e3", func(b *testing.B) {
+		benchmarkFromBigInt(b, 1000, fromBigIntByDiv)
+	})
+	b.Run("div-1e5", func(b *testing.B) {
+		benchmarkFromBigInt(b, 100_000, fromBigIntByDiv)
+	})
+}
+
+/*
+go test -bench=. -benchtime=3x
+	BenchmarkFromBigInt/1e3         	       3	     50284 ns/op
+	BenchmarkFromBigInt/1e5         	       3	  14425811 ns/op
+	BenchmarkFromBigInt/1e6         	       3	 422132481 ns/op
+	BenchmarkFromBigInt/div-1e3     	       3	    113102 ns/op
+	BenchmarkFromBigInt/div-1e5     	       3	 882878213 ns/op
+*/
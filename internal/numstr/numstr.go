@@ -0,0 +1,297 @@
+// Package numstr validates and normalizes number strings given to
+// ConvertString functions of language packages
+package numstr
+
+import (
+	"math/big"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ilius/num2words"
+)
+
+func isSign(c rune) bool {
+	return c == '-' || c == '+' || c == '\u2212'
+}
+
+// thousands separators accepted between digits in non-strict mode
+func isSeparator(c rune) bool {
+	switch c {
+	case ',', '_', ' ', '\'', '\u00a0', '\u202f', '\u066c':
+		return true
+	}
+	return false
+}
+
+// DigitValue returns the value of an ASCII, Arabic-Indic (٠-٩) or
+// Extended Arabic-Indic / Persian (۰-۹) digit
+func DigitValue(c rune) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return byte(c - '0'), true
+	case c >= '\u0660' && c <= '\u0669':
+		return byte(c - '\u0660'), true
+	case c >= '\u06f0' && c <= '\u06f9':
+		return byte(c - '\u06f0'), true
+	}
+	return 0, false
+}
+
+func isDigit(c rune) bool {
+	_, ok := DigitValue(c)
+	return ok
+}
+
+// Validate checks that str is a non-empty string of digits, see DigitValue
+func Validate(str string) error {
+	if str == "" {
+		return num2words.ErrEmpty
+	}
+	for i, c := range str {
+		if isDigit(c) {
+			continue
+		}
+		if isSign(c) && i == 0 && len(str) > 1 {
+			return &num2words.SignError{Input: str, Offset: i, Sign: c}
+		}
+		return &num2words.InvalidCharError{Input: str, Offset: i, Char: c}
+	}
+	return nil
+}
+
+// Number is the result of Parse
+type Number struct {
+	Input    string
+	Digits   string // only ASCII digits
+	Negative bool
+
+	sign       rune
+	signOffset int
+}
+
+// SignError returns error for when the sign of number is not supported
+func (n *Number) SignError() error {
+	return &num2words.SignError{
+		Input:  n.Input,
+		Offset: n.signOffset,
+		Sign:   n.sign,
+	}
+}
+
+// BigIntSignError returns the error of negative bn given to functions
+// that only accept non-negative numbers
+func BigIntSignError(bn *big.Int) error {
+	return &num2words.SignError{
+		Input:  bn.String(),
+		Offset: 0,
+		Sign:   '-',
+	}
+}
+
+// Parse normalizes str into a Number
+// Digits can be ASCII, Arabic-Indic or Persian (see DigitValue), and
+// mixed together, they are converted to ASCII digits.
+//
+// If strict is true, str must only have digits (see Validate), and leading
+// zeros are kept.
+//
+// Otherwise surrounding white space is ignored, the number may start with a
+// sign ("+", "-" or "−"), may have thousands separators (",", "_", "'", "٬",
+// space or no-break space) between groups of 3 digits, or Indian groups
+// like "1,50,00,000", and leading zeros are removed. Misplaced separators,
+// like in "1,5" or "0,001", are *num2words.InvalidCharError.
+func Parse(str string, strict bool) (*Number, error) {
+	if strict {
+		if err := Validate(str); err != nil {
+			return nil, err
+		}
+		return &Number{Input: str, Digits: toASCII(str)}, nil
+	}
+	n := &Number{Input: str}
+	start, end := 0, len(str)
+	for start < end {
+		c, size := utf8.DecodeRuneInString(str[start:])
+		if !unicode.IsSpace(c) {
+			break
+		}
+		start += size
+	}
+	for end > start {
+		c, size := utf8.DecodeLastRuneInString(str[:end])
+		if !unicode.IsSpace(c) {
+			break
+		}
+		end -= size
+	}
+	if start == end {
+		return nil, num2words.ErrEmpty
+	}
+	i := start
+	if c, size := utf8.DecodeRuneInString(str[i:end]); isSign(c) {
+		n.sign = c
+		n.signOffset = i
+		n.Negative = c != '+'
+		i += size
+		if i == end {
+			return nil, &num2words.InvalidCharError{Input: str, Offset: n.signOffset, Char: c}
+		}
+	}
+	digits := make([]byte, 0, end-i)
+	var seps []separator
+	count := 0    // digits read, with leading zeros
+	lead := false // first digit is zero
+	for i < end {
+		c, size := utf8.DecodeRuneInString(str[i:end])
+		d, ok := DigitValue(c)
+		switch {
+		case ok:
+			if count == 0 {
+				lead = d == 0
+			}
+			count++
+			if d == 0 && len(digits) == 1 && digits[0] == '0' {
+				break // leading zero
+			}
+			if len(digits) == 1 && digits[0] == '0' {
+				digits = digits[:0]
+			}
+			digits = append(digits, '0'+d)
+		case isSeparator(c) && len(digits) > 0 && i+size < end:
+			next, _ := utf8.DecodeRuneInString(str[i+size : end])
+			if !isDigit(next) {
+				return nil, &num2words.InvalidCharError{Input: str, Offset: i + size, Char: next}
+			}
+			seps = append(seps, separator{offset: i, char: c, count: count})
+		default:
+			return nil, &num2words.InvalidCharError{Input: str, Offset: i, Char: c}
+		}
+		i += size
+	}
+	if sep := misplaced(seps, count, lead); sep != nil {
+		return nil, &num2words.InvalidCharError{Input: str, Offset: sep.offset, Char: sep.char}
+	}
+	n.Digits = string(digits)
+	return n, nil
+}
+
+// separator is a thousands separator read by Parse
+type separator struct {
+	offset int
+	char   rune
+	count  int // digits before it
+}
+
+// misplaced returns the first separator that does not split groups of 3
+// digits, or Indian groups of 2 digits before the last 3 ("1,50,00,000"),
+// or nil if all are in place. total is the count of digits
+// The first group can not start with zero (lead): "0,001" is not grouped
+func misplaced(seps []separator, total int, lead bool) *separator {
+	if len(seps) == 0 {
+		return nil
+	}
+	if seps[0].count > 3 || lead {
+		return &seps[0]
+	}
+	middle := 0 // size of groups between separators
+	for j := range seps {
+		size := total - seps[j].count
+		if j+1 < len(seps) {
+			size = seps[j+1].count - seps[j].count
+		}
+		switch {
+		case j+1 == len(seps):
+			if size != 3 {
+				return &seps[j]
+			}
+		case middle == 0 && (size == 2 || size == 3):
+			middle = size
+		case size != middle:
+			return &seps[j]
+		}
+	}
+	return nil
+}
+
+// toASCII converts digits of a valid str to ASCII
+func toASCII(str string) string {
+	ascii := true
+	for i := range len(str) {
+		if str[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return str
+	}
+	digits := make([]byte, 0, len(str))
+	for _, c := range str {
+		d, _ := DigitValue(c)
+		digits = append(digits, '0'+d)
+	}
+	return string(digits)
+}
+
+// isCodeSeparator returns true for characters allowed between digits of
+// codes and phone numbers, like "(415) 555-0123"
+func isCodeSeparator(c rune) bool {
+	switch c {
+	case '-', '.', '/', '(', ')':
+		return true
+	}
+	return isSeparator(c) || unicode.IsSpace(c)
+}
+
+// SplitDigits returns the digits of a code or phone number (converted to
+// ASCII, leading zeros are kept) in groups.
+//
+// If pattern is empty, groups are made by separators of str, which can be
+// white space or any of ",_'-./()" (see isCodeSeparator). Otherwise,
+// separators are ignored and digits are grouped by pattern sizes, the last
+// size is repeated for the rest: [3, 3, 4] or [4].
+//
+// If strict is true, str must only have digits.
+func SplitDigits(str string, pattern []int, strict bool) ([]string, error) {
+	if strict {
+		if err := Validate(str); err != nil {
+			return nil, err
+		}
+	}
+	groups := []string{}
+	digits := make([]byte, 0, len(str))
+	start := 0 // start of current group in digits
+	for i, c := range str {
+		d, ok := DigitValue(c)
+		switch {
+		case ok:
+			digits = append(digits, '0'+d)
+		case isCodeSeparator(c):
+			if len(digits) > start {
+				groups = append(groups, string(digits[start:]))
+				start = len(digits)
+			}
+		default:
+			return nil, &num2words.InvalidCharError{Input: str, Offset: i, Char: c}
+		}
+	}
+	if len(digits) == 0 {
+		return nil, num2words.ErrEmpty
+	}
+	if len(digits) > start {
+		groups = append(groups, string(digits[start:]))
+	}
+	if len(pattern) == 0 {
+		return groups, nil
+	}
+	groups = groups[:0]
+	for i, k := 0, 0; i < len(digits); k++ {
+		size := pattern[min(k, len(pattern)-1)]
+		if size <= 0 {
+			size = len(digits)
+		}
+		end := min(i+size, len(digits))
+		groups = append(groups, string(digits[i:end]))
+		i = end
+	}
+	return groups, nil
+}
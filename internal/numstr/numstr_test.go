@@ -0,0 +1,196 @@
+package numstr_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+)
+
+func TestValidate(t *testing.T) {
+	is := is.New(t).Lax()
+	is.NotErr(numstr.Validate("0"))
+	is.NotErr(numstr.Validate("1234567890"))
+	is.NotErr(numstr.Validate("۱۲۳۴۵۶۷۸۹۰"))
+	is.NotErr(numstr.Validate("١٢٣٤٥٦٧٨٩٠"))
+	is.True(errors.Is(numstr.Validate(""), num2words.ErrEmpty))
+
+	testChar := func(str string, offset int, char rune) {
+		err := numstr.Validate(str)
+		is.True(errors.Is(err, num2words.ErrInvalidChar))
+		var charErr *num2words.InvalidCharError
+		if !is.True(errors.As(err, &charErr)) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(charErr.Offset, offset)
+		is.Msg("str=%#v", str).Equal(charErr.Char, char)
+	}
+	testChar("12x4", 2, 'x')
+	testChar("1234 ", 4, ' ')
+	testChar("۱۲x", 4, 'x')
+	testChar("12a4", 2, 'a')
+	testChar("-", 0, '-')
+	testChar("1-2", 1, '-')
+
+	testSign := func(str string, sign rune) {
+		err := numstr.Validate(str)
+		is.True(errors.Is(err, num2words.ErrUnsupportedSign))
+		var signErr *num2words.SignError
+		if !is.True(errors.As(err, &signErr)) {
+			return
+		}
+		is.Equal(signErr.Sign, sign)
+		is.Equal(signErr.Offset, 0)
+	}
+	testSign("-12", '-')
+	testSign("+12", '+')
+}
+
+func TestDigitValue(t *testing.T) {
+	is := is.New(t).Lax()
+	for i, c := range []rune("0123456789") {
+		d, ok := numstr.DigitValue(c)
+		is.True(ok)
+		is.Equal(d, byte(i))
+	}
+	for i, c := range []rune("۰۱۲۳۴۵۶۷۸۹") {
+		d, ok := numstr.DigitValue(c)
+		is.True(ok)
+		is.Equal(d, byte(i))
+	}
+	for i, c := range []rune("٠١٢٣٤٥٦٧٨٩") {
+		d, ok := numstr.DigitValue(c)
+		is.True(ok)
+		is.Equal(d, byte(i))
+	}
+	_, ok := numstr.DigitValue('a')
+	is.False(ok)
+	_, ok = numstr.DigitValue('\u06fa')
+	is.False(ok)
+}
+
+func TestErrorMessage(t *testing.T) {
+	is := is.New(t)
+	is.Equal(
+		numstr.Validate("12x4").Error(),
+		`num2words: invalid character 'x' at offset 2 in "12x4"`,
+	)
+}
+
+func TestParse(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, digits string, negative bool) {
+		n, err := numstr.Parse(str, false)
+		if !is.Msg("str=%#v", str).NotErr(err) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(n.Digits, digits)
+		is.Msg("str=%#v", str).Equal(n.Negative, negative)
+	}
+	test("0", "0", false)
+	test("0000", "0", false)
+	test("-0", "0", true)
+	test("42", "42", false)
+	test("-42", "42", true)
+	test("+42", "42", false)
+	test("−42", "42", true)
+	test("007", "7", false)
+	test(" 42\n", "42", false)
+	test("1,234,567", "1234567", false)
+	test("1_000", "1000", false)
+	test("1 000", "1000", false)
+	test("1 000", "1000", false)
+	test("1'000'000", "1000000", false)
+	test("1,50,00,000", "15000000", false)
+	test("-1,000", "1000", true)
+	test("100,00,00,00,00,000", "10000000000000", false)
+	test("۱۲۳۴", "1234", false)
+	test("١٢٣٤", "1234", false)
+	test("۱٢3٤", "1234", false)
+	test("-۱٬۲۳۴٬۵۶۷", "1234567", true)
+	test("۰۰۰", "0", false)
+
+	testErr := func(str string, offset int, char rune) {
+		_, err := numstr.Parse(str, false)
+		var charErr *num2words.InvalidCharError
+		if !is.Msg("str=%#v", str).True(errors.As(err, &charErr)) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(charErr.Offset, offset)
+		is.Msg("str=%#v", str).Equal(charErr.Char, char)
+	}
+	testErr("1,,000", 2, ',')
+	testErr(",1000", 0, ',')
+	testErr("1000,", 4, ',')
+	testErr("--1", 1, '-')
+	testErr(" - ", 1, '-')
+	testErr("1 - 2", 2, '-')
+	testErr("12x", 2, 'x')
+
+	// misplaced separators
+	testErr("-0,001", 2, ',')
+	testErr("1,00", 1, ',')
+	testErr("1,50", 1, ',')
+	testErr("1,5", 1, ',')
+	testErr("1234,567", 4, ',')
+	testErr("1,0000,000", 1, ',')
+	testErr("1,000,00", 5, ',')
+	testErr("1,00,000,000", 4, ',')
+	testErr("1,000,00,000", 5, ',')
+
+	_, err := numstr.Parse(" \t", false)
+	is.True(errors.Is(err, num2words.ErrEmpty))
+}
+
+func TestParseStrict(t *testing.T) {
+	is := is.New(t).Lax()
+	n, err := numstr.Parse("0012", true)
+	is.NotErr(err)
+	is.Equal(n.Digits, "0012")
+
+	n, err = numstr.Parse("۰۰۱٢3", true)
+	is.NotErr(err)
+	is.Equal(n.Digits, "00123")
+
+	_, err = numstr.Parse("-12", true)
+	is.True(errors.Is(err, num2words.ErrUnsupportedSign))
+
+	_, err = numstr.Parse("1,000", true)
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+}
+
+func TestSplitDigits(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, pattern []int, expected ...string) {
+		groups, err := numstr.SplitDigits(str, pattern, false)
+		is.NotErr(err)
+		is.Msg("str=%#v, pattern=%v", str, pattern).Equal(groups, expected)
+	}
+	test("4155550123", nil, "4155550123")
+	test("415 555 0123", nil, "415", "555", "0123")
+	test("(415) 555-0123", nil, "415", "555", "0123")
+	test(" 415.555.0123 ", nil, "415", "555", "0123")
+	test("۰۱۲-٣٤", nil, "012", "34")
+	test("4155550123", []int{3, 3, 4}, "415", "555", "0123")
+	test("(415) 555-0123", []int{3, 3, 4}, "415", "555", "0123")
+	test("4111111111111111", []int{4}, "4111", "1111", "1111", "1111")
+	test("12345", []int{2}, "12", "34", "5")
+	test("1234", []int{3, 3, 4}, "123", "4")
+	test("1234", []int{0}, "1234")
+
+	_, err := numstr.SplitDigits("", nil, false)
+	is.True(errors.Is(err, num2words.ErrEmpty))
+	_, err = numstr.SplitDigits("- ()", nil, false)
+	is.True(errors.Is(err, num2words.ErrEmpty))
+	_, err = numstr.SplitDigits("415x", nil, false)
+	var charErr *num2words.InvalidCharError
+	is.True(errors.As(err, &charErr))
+	is.Equal(charErr.Offset, 3)
+	_, err = numstr.SplitDigits("415 555", nil, true)
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	groups, err := numstr.SplitDigits("0123", []int{2}, true)
+	is.NotErr(err)
+	is.Equal(groups, []string{"01", "23"})
+}
@@ -0,0 +1,214 @@
+// Package stream reads 3-digit groups of a number from an io.Reader,
+// most significant first, without keeping the whole number in memory
+package stream
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+)
+
+// Info is the result of the first pass over the digits
+type Info struct {
+	// number of digits without leading zeros, 0 if number is zero
+	Digits int
+
+	// index of the least significant non-zero group, -1 if number is zero
+	Lowest int
+}
+
+// GroupCount returns the number of 3-digit groups
+func (info *Info) GroupCount() int {
+	return (info.Digits + 2) / 3
+}
+
+// scan validates digits and returns Info
+// White space is allowed anywhere, so numbers can be split into lines
+func scan(r io.Reader) (*Info, error) {
+	br := bufio.NewReader(r)
+	info := &Info{Lowest: -1}
+	offset := 0
+	lastNonZero := 0 // position of last non-zero digit, 1-based
+	empty := true
+	for {
+		c, size, err := br.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		d, ok := numstr.DigitValue(c)
+		switch {
+		case ok:
+			empty = false
+			if d == 0 && info.Digits == 0 {
+				break // leading zero
+			}
+			info.Digits++
+			if d != 0 {
+				lastNonZero = info.Digits
+			}
+		case unicode.IsSpace(c):
+		default:
+			return nil, &num2words.InvalidCharError{Offset: offset, Char: c}
+		}
+		offset += size
+	}
+	if empty {
+		return nil, num2words.ErrEmpty
+	}
+	if info.Digits > 0 {
+		info.Lowest = (info.Digits - lastNonZero) / 3
+	}
+	return info, nil
+}
+
+// readGroups calls fn for each group of a valid number, most significant
+// first, with i being the group index
+func readGroups(r io.Reader, info *Info, fn func(i int, p uint16) error) error {
+	if info.Digits == 0 {
+		return nil
+	}
+	br := bufio.NewReader(r)
+	i := info.GroupCount() - 1
+	size := info.Digits - 3*i // digits in the first group
+	var p uint16
+	n := 0
+	started := false
+	for i >= 0 {
+		c, _, err := br.ReadRune()
+		if err != nil {
+			if err == io.EOF {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		d, ok := numstr.DigitValue(c)
+		if !ok {
+			continue
+		}
+		if !started && d == 0 {
+			continue
+		}
+		started = true
+		p = p*10 + uint16(d)
+		n++
+		if n < size {
+			continue
+		}
+		if err := fn(i, p); err != nil {
+			return err
+		}
+		i--
+		p, n, size = 0, 0, 3
+	}
+	return nil
+}
+
+// spool copies r into a temporary file and returns it, for readers that
+// can not seek
+func spool(r io.Reader) (*os.File, error) {
+	file, err := os.CreateTemp("", "num2words-")
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.Copy(file, r)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, err
+	}
+	return file, nil
+}
+
+// Groups reads number from r twice, first to validate and count digits,
+// then calls check with the Info and fn for every group (most significant
+// first). If r can not seek, it is copied into a temporary file first.
+func Groups(
+	r io.Reader,
+	check func(info *Info) error,
+	fn func(i int, p uint16) error,
+) error {
+	var start int64
+	rs, ok := r.(io.ReadSeeker)
+	if ok {
+		var err error
+		start, err = rs.Seek(0, io.SeekCurrent)
+		ok = err == nil // false for pipes
+	}
+	if !ok {
+		file, err := spool(r)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			file.Close()
+			os.Remove(file.Name())
+		}()
+		rs = file
+	}
+	info, err := scan(rs)
+	if err != nil {
+		return err
+	}
+	if err := check(info); err != nil {
+		return err
+	}
+	if _, err := rs.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+	return readGroups(rs, info, fn)
+}
+
+// Writer writes words to an io.Writer, with letter case of Options
+type Writer struct {
+	w         *bufio.Writer
+	o         *num2words.Options
+	started   bool
+	wordStart bool // next string starts a new word
+}
+
+func NewWriter(w io.Writer, o *num2words.Options) *Writer {
+	return &Writer{
+		w:         bufio.NewWriter(w),
+		o:         o,
+		wordStart: true,
+	}
+}
+
+// WriteString writes str after applying letter case
+// With num2words.CaseSentence, only the first string is capitalized, and
+// with num2words.CaseTitle, str is capitalized only if the last string
+// ended a word
+func (sw *Writer) WriteString(str string) error {
+	if sw.started && sw.o.Case == num2words.CaseSentence {
+		str = strings.ToLower(str)
+	} else {
+		str = sw.o.ApplyCaseAt(str, sw.wordStart)
+	}
+	sw.started = true
+	if r, size := utf8.DecodeLastRuneInString(str); size > 0 {
+		sw.wordStart = unicode.IsSpace(r) || r == '-'
+	}
+	_, err := sw.w.WriteString(str)
+	return err
+}
+
+// Started returns true if anything is written
+func (sw *Writer) Started() bool {
+	return sw.started
+}
+
+func (sw *Writer) Flush() error {
+	return sw.w.Flush()
+}
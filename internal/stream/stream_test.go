@@ -0,0 +1,93 @@
+package stream_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/stream"
+)
+
+type group struct {
+	i int
+	p uint16
+}
+
+func readAll(r io.Reader) (*stream.Info, []group, error) {
+	var info *stream.Info
+	groups := []group{}
+	err := stream.Groups(r, func(i *stream.Info) error {
+		info = i
+		return nil
+	}, func(i int, p uint16) error {
+		groups = append(groups, group{i, p})
+		return nil
+	})
+	return info, groups, err
+}
+
+func TestGroups(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, digits int, lowest int, groups []group) {
+		for _, r := range []io.Reader{
+			strings.NewReader(str),
+			struct{ io.Reader }{strings.NewReader(str)}, // can not seek
+		} {
+			info, actual, err := readAll(r)
+			if !is.Msg("str=%#v", str).NotErr(err) {
+				return
+			}
+			is.Msg("str=%#v", str).Equal(info.Digits, digits)
+			is.Msg("str=%#v", str).Equal(info.Lowest, lowest)
+			is.Msg("str=%#v", str).Equal(actual, groups)
+		}
+	}
+	test("0", 0, -1, []group{})
+	test("000\n", 0, -1, []group{})
+	test("7", 1, 0, []group{{0, 7}})
+	test("1234567", 7, 0, []group{{2, 1}, {1, 234}, {0, 567}})
+	test("001234000", 7, 1, []group{{2, 1}, {1, 234}, {0, 0}})
+	test("12\n345\n678 000 000\n", 14, 2, []group{
+		{4, 12}, {3, 345}, {2, 678}, {1, 0}, {0, 0},
+	})
+	test("۱۲٣4", 4, 0, []group{{1, 1}, {0, 234}})
+}
+
+func TestGroupsSeekOffset(t *testing.T) {
+	is := is.New(t)
+	r := strings.NewReader("xx1234")
+	_, _ = r.Seek(2, io.SeekStart)
+	_, groups, err := readAll(r)
+	is.NotErr(err)
+	is.Equal(groups, []group{{1, 1}, {0, 234}})
+}
+
+func TestGroupsErrors(t *testing.T) {
+	is := is.New(t).Lax()
+	_, _, err := readAll(strings.NewReader(""))
+	is.True(errors.Is(err, num2words.ErrEmpty))
+	_, _, err = readAll(strings.NewReader(" \n"))
+	is.True(errors.Is(err, num2words.ErrEmpty))
+
+	_, _, err = readAll(strings.NewReader("12\n3x4"))
+	var charErr *num2words.InvalidCharError
+	if is.True(errors.As(err, &charErr)) {
+		is.Equal(charErr.Offset, 4)
+		is.Equal(charErr.Char, 'x')
+		is.Equal(err.Error(), `num2words: invalid character 'x' at offset 4`)
+	}
+
+	called := false
+	errCheck := errors.New("check failed")
+	err = stream.Groups(strings.NewReader("123"), func(*stream.Info) error {
+		return errCheck
+	}, func(int, uint16) error {
+		called = true
+		return nil
+	})
+	is.Equal(err, errCheck)
+	is.False(called)
+}
@@ -0,0 +1,83 @@
+// Package tokens builds the list of num2words.Token for language packages
+package tokens
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/ilius/num2words"
+)
+
+// List builds a list of tokens
+type List struct {
+	Tokens []num2words.Token
+
+	sep string // pending separator for next token
+}
+
+// Add adds a token, with pending separator (see Space) as Sep
+func (l *List) Add(kind num2words.TokenKind, text string, group int) {
+	l.Tokens = append(l.Tokens, num2words.Token{
+		Kind:  kind,
+		Text:  text,
+		Sep:   l.sep,
+		Group: group,
+	})
+	l.sep = ""
+}
+
+// Space adds sep to the separator of next token
+func (l *List) Space(sep string) {
+	l.sep += sep
+}
+
+// Conjunction adds conj which is a separator like ", " or " و " or "у "
+// The surrounding white space go to separators, and the rest (if any)
+// is added as a TokenConjunction
+func (l *List) Conjunction(conj string, group int) {
+	text := strings.TrimLeftFunc(conj, unicode.IsSpace)
+	l.Space(conj[:len(conj)-len(text)])
+	if text == "" {
+		return
+	}
+	trimmed := strings.TrimRightFunc(text, unicode.IsSpace)
+	l.Add(num2words.TokenConjunction, trimmed, group)
+	l.Space(text[len(trimmed):])
+}
+
+// Last returns the last token, or nil if the list is empty
+func (l *List) Last() *num2words.Token {
+	if len(l.Tokens) == 0 {
+		return nil
+	}
+	return &l.Tokens[len(l.Tokens)-1]
+}
+
+// Len returns the number of tokens
+func (l *List) Len() int {
+	return len(l.Tokens)
+}
+
+// String returns the joined string of tokens, without changing case
+func (l *List) String() string {
+	return num2words.JoinTokens(l.Tokens)
+}
+
+// Result applies case option to tokens and returns them
+func (l *List) Result(o *num2words.Options) []num2words.Token {
+	o.ApplyCaseTokens(l.Tokens)
+	return l.Tokens
+}
+
+// SmallKind returns kind of the word of num < 1000 that has its own word
+func SmallKind(num uint16) num2words.TokenKind {
+	switch {
+	case num < 10:
+		return num2words.TokenDigit
+	case num < 20:
+		return num2words.TokenTeen
+	case num < 100:
+		return num2words.TokenTens
+	}
+	return num2words.TokenHundred
+}
@@ -0,0 +1,246 @@
+// Package wordparse reads numbers written in words, for languages that
+// add groups with a conjunction and compound scale words like persian and
+// tajik: "two hundred and thirty five thousand", "million milliard"
+package wordparse
+
+import (
+	"math/big"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+)
+
+var (
+	big_ten     = big.NewInt(10)
+	big_hundred = big.NewInt(100)
+)
+
+// Word is a word of the input with its byte offset
+type Word struct {
+	Text   string // normalized text, without conjunction suffix
+	Orig   string
+	Offset int
+	And    bool // had a conjunction suffix, like tajik "бисту"
+}
+
+// Language has the words read by Parse
+type Language struct {
+	// Small maps words of numbers below 1000 to their values
+	Small map[string]uint16
+
+	// Scales maps scale words to their exponent of 10
+	Scales map[string]int
+
+	// Compound is the exponent of the only scale word that is multiplied
+	// after another scale word, like "milliard" in "million milliard"
+	Compound int
+
+	// And are the conjunction words
+	And []string
+
+	// Negative is the word of negative numbers
+	Negative string
+
+	// CardinalOf removes the ordinal suffix of the last word, if it has one
+	CardinalOf func(word string) string
+}
+
+func (lang *Language) isAnd(word string) bool {
+	for _, and := range lang.And {
+		if word == and {
+			return true
+		}
+	}
+	return false
+}
+
+// smallKind is the kind of last word of a number below 1000
+type smallKind uint8
+
+const (
+	smallNone smallKind = iota
+	smallHundred
+	smallTens
+	smallLast // units, teens and numerals, nothing can follow them
+)
+
+// parser reads number words one by one
+type parser struct {
+	lang  *Language
+	input string
+	total *big.Int // sum of groups before group
+	group *big.Int // value of last group with scale words, or nil
+	exp   int      // exponent of scale words of group
+	last  int      // exponent of the group before group, or -1
+	cur   *big.Int // value of words after the last scale word
+	kind  smallKind
+	scale bool // previous word is a scale word
+	and   bool // previous word is a conjunction
+	zero  bool
+}
+
+func (p *parser) wordError(w Word) error {
+	return &num2words.WordError{
+		Input:  p.input,
+		Offset: w.Offset,
+		Word:   w.Orig,
+	}
+}
+
+// addSmall adds a number below 1000 given by a word
+func (p *parser) addSmall(w Word, n uint16) error {
+	kind := smallLast
+	switch {
+	case n >= 100:
+		kind = smallHundred
+	case n >= 20 && n%10 == 0:
+		kind = smallTens
+	}
+	if n == 100 && p.kind == smallLast && !p.and && p.cur.Cmp(big_ten) < 0 {
+		// "four hundred" written apart
+		p.cur.Mul(p.cur, big_hundred)
+		p.kind = smallHundred
+		p.scale = false
+		return nil
+	}
+	if p.kind >= kind || p.kind == smallTens && n >= 10 {
+		// "five five", "twenty thirty", "twenty eleven"
+		return p.wordError(w)
+	}
+	p.cur.Add(p.cur, big.NewInt(int64(n)))
+	p.kind = kind
+	p.scale = false
+	p.and = false
+	return nil
+}
+
+// addScale adds a scale word 10^exp
+// Language.Compound after another scale word is multiplied: "million
+// milliard", other scale words start a new group: "one million thousand"
+func (p *parser) addScale(w Word, exp int) error {
+	scale := new(big.Int).Exp(big_ten, big.NewInt(int64(exp)), nil)
+	if p.scale && exp == p.lang.Compound {
+		p.group.Mul(p.group, scale)
+		p.exp += exp
+	} else {
+		if p.and && p.cur.Sign() != 0 {
+			// "two and thousand"
+			return p.wordError(w)
+		}
+		if p.cur.Sign() == 0 {
+			// "thousand"
+			p.cur.SetInt64(1)
+		}
+		if p.group != nil {
+			p.total.Add(p.total, p.group)
+			p.last = p.exp
+		}
+		p.group = p.cur.Mul(p.cur, scale)
+		p.exp = exp
+		p.cur = new(big.Int)
+		p.kind = smallNone
+	}
+	if p.last >= 0 && p.exp >= p.last {
+		// "two thousand and three million"
+		return p.wordError(w)
+	}
+	p.scale = true
+	p.and = false
+	return nil
+}
+
+// add parses one word without ordinal suffix
+func (p *parser) add(w Word, word string) error {
+	if p.zero {
+		return p.wordError(w)
+	}
+	if n, ok := p.lang.Small[word]; ok {
+		if n == 0 {
+			if p.group != nil || p.kind != smallNone {
+				return p.wordError(w)
+			}
+			p.zero = true
+			return nil
+		}
+		return p.addSmall(w, n)
+	}
+	if exp, ok := p.lang.Scales[word]; ok {
+		return p.addScale(w, exp)
+	}
+	if _, ok := numstr.DigitValue([]rune(word)[0]); ok {
+		// "2 million"
+		n, err := numstr.Parse(word, false)
+		if err != nil || n.Negative || p.kind != smallNone {
+			return p.wordError(w)
+		}
+		p.cur.SetString(n.Digits, 10)
+		p.kind = smallLast
+		p.scale = false
+		p.and = false
+		return nil
+	}
+	return p.wordError(w)
+}
+
+// Parse reads a number from words of input
+// Conjunctions may be missing or repeated, but not at the end
+// Returns *num2words.WordError for words that are not expected, and
+// num2words.ErrEmpty if there are no words
+func (lang *Language) Parse(input string, words []Word) (*big.Int, error) {
+	p := &parser{
+		lang:  lang,
+		input: input,
+		total: new(big.Int),
+		last:  -1,
+		cur:   new(big.Int),
+	}
+	negative := false
+	numbers := 0
+	var and Word // last conjunction
+	for i, w := range words {
+		word := w.Text
+		switch {
+		case lang.isAnd(word) && !w.And:
+			and = w
+			p.and = true
+			p.scale = false
+			continue
+		case word == lang.Negative && numbers == 0 && !w.And:
+			if negative {
+				return nil, p.wordError(w)
+			}
+			negative = true
+			continue
+		case i == len(words)-1:
+			word = lang.CardinalOf(word)
+		}
+		err := p.add(w, word)
+		if err != nil {
+			return nil, err
+		}
+		numbers++
+		if w.And {
+			and = w
+			p.and = true
+			p.scale = false
+		}
+	}
+	if numbers == 0 {
+		if len(words) > 0 {
+			return nil, p.wordError(words[len(words)-1])
+		}
+		return nil, num2words.ErrEmpty
+	}
+	if p.and {
+		// "five and"
+		return nil, p.wordError(and)
+	}
+	n := p.total.Add(p.total, p.cur)
+	if p.group != nil {
+		n.Add(n, p.group)
+	}
+	if negative {
+		n.Neg(n)
+	}
+	return n, nil
+}
@@ -0,0 +1,94 @@
+package wordparse_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/wordparse"
+)
+
+var lang = &wordparse.Language{
+	Small: map[string]uint16{
+		"zero": 0, "one": 1, "two": 2, "three": 3, "five": 5, "eleven": 11,
+		"twenty": 20, "thirty": 30, "hundred": 100, "two-hundred": 200,
+	},
+	Scales: map[string]int{
+		"thousand": 3,
+		"million":  6,
+		"milliard": 9,
+	},
+	Compound: 9,
+	And:      []string{"and"},
+	Negative: "minus",
+	CardinalOf: func(word string) string {
+		cardinal, _ := strings.CutSuffix(word, "th")
+		return cardinal
+	},
+}
+
+// split splits str by spaces, cutting "&" suffix as conjunction
+func split(str string) []wordparse.Word {
+	var words []wordparse.Word
+	offset := 0
+	for _, text := range strings.Split(str, " ") {
+		w := wordparse.Word{Text: text, Orig: text, Offset: offset}
+		w.Text, w.And = strings.CutSuffix(text, "&")
+		words = append(words, w)
+		offset += len(text) + 1
+	}
+	return words
+}
+
+func TestParse(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string) {
+		n, err := lang.Parse(str, split(str))
+		if !is.Msg("str=%#v", str).NotErr(err) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(n.String(), expected)
+	}
+	test("zero", "0")
+	test("twenty and three", "23")
+	test("twenty& three", "23")
+	test("twenty three", "23")
+	test("two hundred and five", "205")
+	test("two-hundred thirty thousand", "230000")
+	test("one million and two thousand", "1002000")
+	test("one million milliard", "1000000000000000")
+	test("thousand and one", "1001")
+	test("2 million", "2000000")
+	test("minus five", "-5")
+	test("twentyth", "20")
+}
+
+func TestParseError(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, offset int, word string) {
+		_, err := lang.Parse(str, split(str))
+		is.Msg("str=%#v", str).True(errors.Is(err, num2words.ErrInvalidWord))
+		var wordErr *num2words.WordError
+		if !is.Msg("str=%#v", str).True(errors.As(err, &wordErr)) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(wordErr.Input, str)
+		is.Msg("str=%#v", str).Equal(wordErr.Offset, offset)
+		is.Msg("str=%#v", str).Equal(wordErr.Word, word)
+	}
+	test("apple", 0, "apple")
+	test("five five", 5, "five")
+	test("twenty eleven", 7, "eleven")
+	test("two and thousand", 8, "thousand")
+	test("two thousand three million", 19, "million")
+	test("thousand thousand", 9, "thousand")
+	test("zero one", 5, "one")
+	test("five and", 5, "and")
+	test("twenty&", 0, "twenty&")
+	test("minus", 0, "minus")
+
+	_, err := lang.Parse("", nil)
+	is.True(errors.Is(err, num2words.ErrEmpty))
+}
@@ -0,0 +1,93 @@
+// Package num2words converts numbers to words in several languages.
+//
+// Each language lives in its own package (english, persian, tajik, arabic)
+// and registers a Converter for its BCP-47 language tag when imported:
+//
+//	import (
+//		"github.com/ilius/num2words"
+//		_ "github.com/ilius/num2words/persian"
+//	)
+//
+//	conv, err := num2words.For("fa")
+package num2words
+
+// Copyright @ 2024 Saeed Rasooli <saeed.gnu@gmail.com> (ilius)
+//
+// This library is free software; you can redistribute it and/or
+// modify it under the terms of the GNU Lesser General Public
+// License as published by the Free Software Foundation; either
+// version 2.1 of the License, or (at your option) any later version.
+//
+// This library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the GNU
+// Lesser General Public License for more details.
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Capability is a bit set of features supported by a Converter
+type Capability uint
+
+const (
+	// Cardinal: ConvertString and ConvertBigInt for non-negative integers
+	Cardinal Capability = 1 << iota
+	// Signed: ConvertBigIntSigned for negative integers
+	Signed
+	// Ordinal: ConvertOrdinalString and ConvertOrdinalBigInt
+	Ordinal
+)
+
+var capabilityNames = []string{
+	"cardinal",
+	"signed",
+	"ordinal",
+}
+
+// Has returns true if all capabilities in c2 are present in c
+func (c Capability) Has(c2 Capability) bool {
+	return c&c2 == c2
+}
+
+func (c Capability) String() string {
+	names := []string{}
+	for i, name := range capabilityNames {
+		if c&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Converter is the common interface implemented by every language package
+type Converter interface {
+	// Tag returns the BCP-47 language tag, for example "en" or "fa"
+	Tag() string
+
+	// Capabilities returns the set of features this language supports.
+	// Methods for missing capabilities return an error wrapping ErrUnsupported
+	Capabilities() Capability
+
+	// ConvertString converts an integer string, which may start with a sign
+	// ("Negative ..." for "-") and have thousands separators between digit
+	// groups. With WithStrict(true), str must only have digits
+	ConvertString(str string, opts ...Option) (string, error)
+
+	// ConvertBigInt: only for non-negative integers, returns an error
+	// matching ErrUnsupportedSign for negative bn
+	ConvertBigInt(bn *big.Int, opts ...Option) (string, error)
+
+	ConvertBigIntSigned(bn *big.Int, opts ...Option) (string, error)
+
+	ConvertOrdinalString(str string, opts ...Option) (string, error)
+
+	ConvertOrdinalBigInt(bn *big.Int, opts ...Option) (string, error)
+}
+
+// Integer is a constraint that permits any native integer type
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
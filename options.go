@@ -0,0 +1,394 @@
+package num2words
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Case is the letter case of the output words
+type Case uint8
+
+const (
+	// CaseDefault keeps the case used by the language, for example
+	// Title Case for english and lower case for tajik
+	CaseDefault Case = iota
+	// CaseLower: "one hundred five"
+	CaseLower
+	// CaseUpper: "ONE HUNDRED FIVE"
+	CaseUpper
+	// CaseTitle: "One Hundred Five"
+	CaseTitle
+	// CaseSentence: "One hundred five"
+	CaseSentence
+)
+
+// Scale is the naming system of large numbers
+type Scale uint8
+
+const (
+	// ScaleShort: 10^9 is "Billion", 10^12 is "Trillion"
+	ScaleShort Scale = iota
+	// ScaleLong: 10^9 is "Milliard", 10^12 is "Billion", 10^15 is
+	// "Thousand Billion" and 10^18 is "Trillion"
+	ScaleLong
+	// ScalePeletier is the long scale with "-illiard" names for odd powers
+	// of thousand: 10^9 is "Milliard", 10^15 is "Billiard"
+	ScalePeletier
+	// ScaleIndian groups digits by 3-2-2 with "Thousand", "Lakh" (10^5) and
+	// "Crore" (10^7), higher numbers are counted in crores: 10^12 is
+	// "One Lakh Crore"
+	ScaleIndian
+	// ScaleIndianArab is like ScaleIndian with "Arab" (10^9) and "Kharab"
+	// (10^11), higher numbers are counted in kharabs
+	ScaleIndianArab
+)
+
+// DecimalStyle is the way of reading digits after decimal point
+type DecimalStyle uint8
+
+const (
+	// DecimalPoint: "Three Point One Four"
+	DecimalPoint DecimalStyle = iota
+	// DecimalFraction: "Three and Fourteen Hundredths"
+	DecimalFraction
+)
+
+// Rounding is the rounding mode of numbers
+type Rounding uint8
+
+const (
+	// RoundHalfUp rounds half away from zero: 2.5 => 3, -2.5 => -3
+	RoundHalfUp Rounding = iota
+	// RoundHalfEven rounds half to the even digit: 2.5 => 2, 3.5 => 4
+	RoundHalfEven
+	// RoundDown truncates toward zero: 2.7 => 2
+	RoundDown
+	// RoundUp rounds away from zero: 2.1 => 3
+	RoundUp
+)
+
+// CompactStyle is the way of reading the leading digits of compact numbers
+type CompactStyle uint8
+
+const (
+	// CompactNumeral: "1.2 Million"
+	CompactNumeral CompactStyle = iota
+	// CompactWords: "One Point Two Million", "Three and a Half Billion"
+	CompactWords
+)
+
+// Qualifier is the word put before compact numbers that are rounded
+type Qualifier uint8
+
+const (
+	// QualifierNone puts no word before rounded numbers
+	QualifierNone Qualifier = iota
+	// QualifierAbout: "About 1.2 Million"
+	QualifierAbout
+	// QualifierDirection puts "Over" if the number was rounded down, and
+	// "Nearly" if it was rounded up
+	QualifierDirection
+)
+
+// Options control the output of convert functions.
+// The zero values of string fields are NOT used as-is, every language
+// fills them with its own defaults before applying the given Option list
+type Options struct {
+	// Case of output letters, ignored by scripts without letter case
+	Case Case
+
+	// GroupSeparator is put between 3-digit groups, for example ", " in
+	// "One Thousand, Five" or " و " in persian
+	GroupSeparator string
+
+	// Conjunction is put between parts of a 3-digit group, for example " " in
+	// "One Hundred Five" or " و " in persian
+	Conjunction string
+
+	// Hyphenate compound tens, for example "Twenty-One" instead of "Twenty One".
+	// Ignored by languages which do not hyphenate numbers
+	Hyphenate bool
+
+	// BritishAnd inserts "and" by British rules, like "One Hundred and Five"
+	// and "One Thousand and Five". Only used by english
+	BritishAnd bool
+
+	// Scale of large number names.
+	// Ignored by languages which have only one scale
+	Scale Scale
+
+	// Decimal is the style of decimal numbers
+	Decimal DecimalStyle
+
+	// Precision is the number of digits after decimal point, the number is
+	// rounded (see Rounding) or padded with zeros.
+	// Negative means all digits of the input
+	Precision int
+
+	// Rounding mode, used with Precision and for amounts of money
+	Rounding Rounding
+
+	// Cheque writes minor units of money as digits, like "and 56/100"
+	// instead of "and Fifty Six Cents"
+	Cheque bool
+
+	// Only adds "Only" after amounts of money
+	Only bool
+
+	// TrailingZeros keeps zeros at the end of decimal digits, like
+	// "Two Point Five Zero" for "2.50"
+	TrailingZeros bool
+
+	// ReduceFraction reduces fractions to lowest terms, like "One Half"
+	// instead of "Four Eighths"
+	ReduceFraction bool
+
+	// DigitGroups is the pattern of group sizes for digit-by-digit reading,
+	// like [3, 3, 4] for phone numbers, the last size is repeated.
+	// If empty, groups are made by separators of the input
+	DigitGroups []int
+
+	// DigitSeparator is put between digit groups in digit-by-digit reading
+	DigitSeparator string
+
+	// Oh reads 0 as "Oh" instead of "Zero" in digit-by-digit reading.
+	// Only used by english
+	Oh bool
+
+	// DigitRepeat reads repeated digits like "Double Five" and
+	// "Triple Zero" in digit-by-digit reading. Only supported by english,
+	// other languages return an error wrapping ErrUnsupported
+	DigitRepeat bool
+
+	// SignificantDigits is the number of significant digits of compact
+	// numbers, zero or negative keeps all digits. Only used by english
+	SignificantDigits int
+
+	// Compact is the style of compact numbers. Only used by english
+	Compact CompactStyle
+
+	// Qualifier is put before compact numbers that are rounded.
+	// Only used by english
+	Qualifier Qualifier
+
+	// Strict only accepts digits in input strings, without sign,
+	// separators or surrounding white space
+	Strict bool
+}
+
+// Option modifies Options, to be passed to convert functions
+type Option func(*Options)
+
+// WithCase sets the letter case of output
+func WithCase(c Case) Option {
+	return func(o *Options) {
+		o.Case = c
+	}
+}
+
+// WithGroupSeparator sets the separator between 3-digit groups
+func WithGroupSeparator(sep string) Option {
+	return func(o *Options) {
+		o.GroupSeparator = sep
+	}
+}
+
+// WithConjunction sets the separator between parts of a 3-digit group
+func WithConjunction(conj string) Option {
+	return func(o *Options) {
+		o.Conjunction = conj
+	}
+}
+
+// WithHyphenation enables or disables hyphenation of compound tens
+func WithHyphenation(hyphenate bool) Option {
+	return func(o *Options) {
+		o.Hyphenate = hyphenate
+	}
+}
+
+// WithBritishAnd enables or disables British "and" (see Options.BritishAnd)
+func WithBritishAnd(british bool) Option {
+	return func(o *Options) {
+		o.BritishAnd = british
+	}
+}
+
+// WithScale sets the naming system of large numbers
+func WithScale(scale Scale) Option {
+	return func(o *Options) {
+		o.Scale = scale
+	}
+}
+
+// WithDecimalStyle sets the style of decimal numbers
+func WithDecimalStyle(style DecimalStyle) Option {
+	return func(o *Options) {
+		o.Decimal = style
+	}
+}
+
+// WithPrecision sets the number of digits after decimal point, or -1 for
+// all digits
+func WithPrecision(precision int) Option {
+	return func(o *Options) {
+		o.Precision = precision
+	}
+}
+
+// WithRounding sets the rounding mode
+func WithRounding(rounding Rounding) Option {
+	return func(o *Options) {
+		o.Rounding = rounding
+	}
+}
+
+// WithCheque enables or disables cheque style for amounts of money
+func WithCheque(cheque bool) Option {
+	return func(o *Options) {
+		o.Cheque = cheque
+	}
+}
+
+// WithOnly enables or disables "Only" after amounts of money
+func WithOnly(only bool) Option {
+	return func(o *Options) {
+		o.Only = only
+	}
+}
+
+// WithTrailingZeros enables or disables trailing zeros of decimal digits
+func WithTrailingZeros(keep bool) Option {
+	return func(o *Options) {
+		o.TrailingZeros = keep
+	}
+}
+
+// WithReduceFraction enables or disables reducing fractions to lowest terms
+func WithReduceFraction(reduce bool) Option {
+	return func(o *Options) {
+		o.ReduceFraction = reduce
+	}
+}
+
+// WithDigitGroups sets the pattern of digit groups in digit-by-digit reading
+func WithDigitGroups(pattern ...int) Option {
+	return func(o *Options) {
+		o.DigitGroups = pattern
+	}
+}
+
+// WithDigitSeparator sets the separator between digit groups in
+// digit-by-digit reading
+func WithDigitSeparator(sep string) Option {
+	return func(o *Options) {
+		o.DigitSeparator = sep
+	}
+}
+
+// WithOh enables or disables reading 0 as "Oh" in digit-by-digit reading
+func WithOh(oh bool) Option {
+	return func(o *Options) {
+		o.Oh = oh
+	}
+}
+
+// WithDigitRepeat enables or disables "Double" and "Triple" in
+// digit-by-digit reading
+func WithDigitRepeat(repeat bool) Option {
+	return func(o *Options) {
+		o.DigitRepeat = repeat
+	}
+}
+
+// WithSignificantDigits sets the number of significant digits of compact
+// numbers
+func WithSignificantDigits(digits int) Option {
+	return func(o *Options) {
+		o.SignificantDigits = digits
+	}
+}
+
+// WithCompactStyle sets the style of compact numbers
+func WithCompactStyle(style CompactStyle) Option {
+	return func(o *Options) {
+		o.Compact = style
+	}
+}
+
+// WithQualifier sets the word put before rounded compact numbers
+func WithQualifier(q Qualifier) Option {
+	return func(o *Options) {
+		o.Qualifier = q
+	}
+}
+
+// WithStrict enables or disables strict parsing of input strings
+func WithStrict(strict bool) Option {
+	return func(o *Options) {
+		o.Strict = strict
+	}
+}
+
+// NewOptions returns a copy of defaults with opts applied.
+// Used by language packages
+func NewOptions(defaults Options, opts []Option) *Options {
+	o := defaults
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &o
+}
+
+// ApplyCase converts the letter case of str based on o.Case
+func (o *Options) ApplyCase(str string) string {
+	switch o.Case {
+	case CaseLower:
+		return strings.ToLower(str)
+	case CaseUpper:
+		return strings.ToUpper(str)
+	case CaseTitle:
+		return toTitle(str)
+	case CaseSentence:
+		str = strings.ToLower(str)
+		r, size := utf8.DecodeRuneInString(str)
+		if size == 0 {
+			return str
+		}
+		return string(unicode.ToUpper(r)) + str[size:]
+	}
+	return str
+}
+
+// ApplyCaseAt is like ApplyCase for str written after other text, start
+// is false if str continues the last word of it, like "у " after
+// "Миллиард" in tajik
+func (o *Options) ApplyCaseAt(str string, start bool) string {
+	if o.Case == CaseTitle {
+		return titleCase(str, start)
+	}
+	return o.ApplyCase(str)
+}
+
+// toTitle upper-cases the first letter of each word (including each part of
+// a hyphenated word) and lower-cases the rest
+func toTitle(str string) string {
+	return titleCase(str, true)
+}
+
+// titleCase is like toTitle, but does not upper-case the first letter
+// if start is false
+func titleCase(str string, start bool) string {
+	var sb strings.Builder
+	sb.Grow(len(str))
+	for _, r := range str {
+		if start {
+			sb.WriteRune(unicode.ToUpper(r))
+		} else {
+			sb.WriteRune(unicode.ToLower(r))
+		}
+		start = unicode.IsSpace(r) || r == '-'
+	}
+	return sb.String()
+}
@@ -0,0 +1,44 @@
+package num2words_test
+
+import (
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+)
+
+func TestApplyCase(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(c num2words.Case, str string, expected string) {
+		o := num2words.NewOptions(num2words.Options{}, []num2words.Option{
+			num2words.WithCase(c),
+		})
+		is.Msg("case=%v, str=%#v", c, str).Equal(o.ApplyCase(str), expected)
+	}
+	test(num2words.CaseDefault, "One Hundred Five", "One Hundred Five")
+	test(num2words.CaseLower, "One Hundred Twenty-One", "one hundred twenty-one")
+	test(num2words.CaseUpper, "One Hundred Twenty-One", "ONE HUNDRED TWENTY-ONE")
+	test(num2words.CaseTitle, "one hundred twenty-one", "One Hundred Twenty-One")
+	test(num2words.CaseSentence, "One Hundred Twenty-One", "One hundred twenty-one")
+	test(num2words.CaseSentence, "", "")
+	test(num2words.CaseTitle, "як ҳазор", "Як Ҳазор")
+	test(num2words.CaseUpper, "هزار و یک", "هزار و یک")
+}
+
+func TestNewOptions(t *testing.T) {
+	is := is.New(t)
+	defaults := num2words.Options{
+		GroupSeparator: ", ",
+		Conjunction:    " ",
+	}
+	o := num2words.NewOptions(defaults, []num2words.Option{
+		num2words.WithGroupSeparator(" "),
+		num2words.WithHyphenation(true),
+	})
+	is.Equal(*o, num2words.Options{
+		GroupSeparator: " ",
+		Conjunction:    " ",
+		Hyphenate:      true,
+	})
+	is.Equal(defaults.GroupSeparator, ", ")
+}
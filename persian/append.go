@@ -0,0 +1,112 @@
+package persian
+
+// fixed arrays filled from small_words, used by AppendWords to avoid
+// map lookups and string concatenation
+var (
+	ones_array     [20]string // 0 to 19
+	tens_array     [10]string // tens_array[2] = "بیست"
+	hundreds_array [10]string // hundreds_array[2] = "دویست"
+)
+
+func init() {
+	for num, word := range small_words {
+		switch {
+		case num < 20:
+			ones_array[num] = word
+		case num < 100:
+			tens_array[num/10] = word
+		}
+	}
+	for h := uint16(1); h < 10; h++ {
+		word, ok := small_words[h*100]
+		if !ok {
+			word = small_words[h] + small_words[100]
+		}
+		hundreds_array[h] = word
+	}
+}
+
+// num < 1000, same as addSmall with default options
+func appendSmall(dst []byte, num uint16) []byte {
+	if num < 20 {
+		return append(dst, ones_array[num]...)
+	}
+	ones := num % 10
+	tens := (num % 100) / 10
+	hundreds := num / 100
+	if hundreds != 0 {
+		dst = append(dst, hundreds_array[hundreds]...)
+		if tens == 0 && ones == 0 {
+			return dst
+		}
+		dst = append(dst, fa_and...)
+	}
+	if tens == 0 {
+		return append(dst, ones_array[ones]...)
+	}
+	if tens == 1 {
+		return append(dst, ones_array[10+ones]...)
+	}
+	dst = append(dst, tens_array[tens]...)
+	if ones != 0 {
+		dst = append(dst, fa_and...)
+		dst = append(dst, ones_array[ones]...)
+	}
+	return dst
+}
+
+// appendOrder appends the scale word of group index i > 0
+func appendOrder(dst []byte, i int) []byte {
+	if i < len(big_words) {
+		return append(dst, big_words[i]...)
+	}
+	d := i / 3
+	m := i % 3
+	if m != 0 {
+		dst = append(dst, big_words[m]...)
+	}
+	for j := range d {
+		if j > 0 || m != 0 {
+			dst = append(dst, zwnj...)
+		}
+		dst = append(dst, big_words[3]...)
+	}
+	return dst
+}
+
+// AppendWords appends words of n to dst and returns the extended buffer
+// It gives the same result as ConvertBigInt with default options, without
+// any memory allocation if dst has enough capacity
+func AppendWords(dst []byte, n uint64) []byte {
+	if n < 1000 {
+		return appendSmall(dst, uint16(n))
+	}
+	var groups [7]uint16
+	k := 0
+	for n > 0 {
+		groups[k] = uint16(n % 1000)
+		n /= 1000
+		k++
+	}
+	first := true
+	for i := k - 1; i >= 0; i-- {
+		p := groups[i]
+		if p == 0 {
+			continue
+		}
+		if !first {
+			dst = append(dst, fa_and...)
+		}
+		first = false
+		if i == 0 {
+			dst = appendSmall(dst, p)
+			continue
+		}
+		if i > 1 || p != 1 {
+			dst = appendSmall(dst, p)
+			dst = append(dst, ' ')
+		}
+		dst = appendOrder(dst, i)
+	}
+	return dst
+}
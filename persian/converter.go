@@ -0,0 +1,49 @@
+package persian
+
+import (
+	"math/big"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+)
+
+func init() {
+	num2words.Register("fa", converter{})
+}
+
+// converter implements num2words.Converter
+type converter struct{}
+
+func (converter) Tag() string {
+	return "fa"
+}
+
+func (converter) Capabilities() num2words.Capability {
+	return num2words.Cardinal | num2words.Signed | num2words.Ordinal
+}
+
+func (converter) ConvertString(str string, opts ...num2words.Option) (string, error) {
+	return ConvertString(str, opts...)
+}
+
+func (converter) ConvertBigInt(bn *big.Int, opts ...num2words.Option) (string, error) {
+	if bn.Sign() < 0 {
+		return "", numstr.BigIntSignError(bn)
+	}
+	return ConvertBigInt(bn, opts...), nil
+}
+
+func (converter) ConvertBigIntSigned(bn *big.Int, opts ...num2words.Option) (string, error) {
+	return ConvertBigIntSigned(bn, opts...), nil
+}
+
+func (converter) ConvertOrdinalString(str string, opts ...num2words.Option) (string, error) {
+	return ConvertOrdinalString(str, opts...)
+}
+
+func (converter) ConvertOrdinalBigInt(bn *big.Int, opts ...num2words.Option) (string, error) {
+	if bn.Sign() < 0 {
+		return "", numstr.BigIntSignError(bn)
+	}
+	return ConvertOrdinalBigInt(bn, opts...), nil
+}
@@ -0,0 +1,54 @@
+package persian
+
+import (
+	"fmt"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
+)
+
+// addDigitSequence adds words of each digit, with group index counted
+// from the last group
+func addDigitSequence(l *tokens.List, groups []string, o *num2words.Options) {
+	for gi, digits := range groups {
+		group := len(groups) - 1 - gi
+		if gi > 0 {
+			l.Conjunction(o.DigitSeparator, group)
+		}
+		for i := range len(digits) {
+			if i > 0 {
+				l.Space(" ")
+			}
+			l.Add(num2words.TokenDigit, small_words[uint16(digits[i]-'0')], group)
+		}
+	}
+}
+
+// ConvertDigitsTokens is like ConvertDigits, but returns tokens
+func ConvertDigitsTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	if o.DigitRepeat {
+		return nil, fmt.Errorf("persian: ConvertDigits: DigitRepeat: %w", num2words.ErrUnsupported)
+	}
+	groups, err := numstr.SplitDigits(str, o.DigitGroups, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	l := &tokens.List{}
+	addDigitSequence(l, groups, o)
+	return l.Result(o), nil
+}
+
+// ConvertDigits reads a code or phone number digit by digit, like
+// "چهار یک پنج، صفر یک"
+// Groups are made by separators of str, or by num2words.WithDigitGroups
+// Returns an error wrapping num2words.ErrUnsupported with
+// num2words.WithDigitRepeat
+func ConvertDigits(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertDigitsTokens(str, opts...)
+	if err != nil {
+		return "", err
+	}
+	return num2words.JoinTokens(result), nil
+}
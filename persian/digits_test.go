@@ -0,0 +1,29 @@
+package persian_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/persian"
+)
+
+func TestConvertDigits(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := persian.ConvertDigits(str, opts...)
+		is.NotErr(err)
+		is.Msg("str=%#v", str).Equal(words, expected)
+	}
+	test("415-01", "چهار یک پنج، صفر یک")
+	test("12345", "یک دو سه، چهار پنج", num2words.WithDigitGroups(3))
+	test("۰۰۷", "صفر صفر هفت")
+	// not used by this language
+	test("007", "صفر صفر هفت", num2words.WithOh(true))
+
+	_, err := persian.ConvertDigits("12a")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	_, err = persian.ConvertDigits("007", num2words.WithDigitRepeat(true))
+	is.True(errors.Is(err, num2words.ErrUnsupported))
+}
@@ -16,23 +16,34 @@ import (
 	"math/big"
 	"strconv"
 	"strings"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/groups"
+	"github.com/ilius/num2words/internal/numstr"
+	"github.com/ilius/num2words/internal/tokens"
 )
 
 var (
-	big_zero     = big.NewInt(0)
-	big_one      = big.NewInt(1)
-	big_ten      = big.NewInt(10)
-	big_thausand = big.NewInt(1000)
+	big_zero = big.NewInt(0)
+	big_one  = big.NewInt(1)
+	big_ten  = big.NewInt(10)
 )
 
 const (
-	zwnj     = "\u200c"
-	fa_and   = " و "
-	fa_zero  = "صفر"
-	fa_first = "اول" // or "یکم"
-	fa_tenth = "دهم"
+	zwnj        = "\u200c"
+	fa_and      = " و "
+	fa_negative = "منفی"
+	fa_zero     = "صفر"
+	fa_first    = "اول" // or "یکم"
+	fa_tenth    = "دهم"
 )
 
+var defaultOptions = num2words.Options{
+	GroupSeparator: fa_and,
+	Conjunction:    fa_and,
+	DigitSeparator: "، ",
+}
+
 var small_words = map[uint16]string{
 	0:   fa_zero,
 	1:   "یک",
@@ -119,197 +130,277 @@ func extractGroupsByString(numStr string) ([]uint16, error) {
 	return groups, nil
 }
 
-func bigIntCountDigits(bnBytes []byte) int {
-	bn := &big.Int{}
-	bn.SetBytes(bnBytes)
-	if bn.Cmp(big_zero) == 0 {
-		return 1
-	}
-	count := 0
-	for bn.Cmp(big_zero) != 0 {
-		bn.Div(bn, big_ten)
-		count++
-	}
-	return count
+func addSign(l *tokens.List) {
+	l.Add(num2words.TokenSign, fa_negative, -1)
+	l.Space(" ")
 }
 
-func extractGroupsByBigInt(bn *big.Int, digitCount int) []uint16 {
-	groupCount := digitCount / 3
-	groups := make([]uint16, groupCount)
-	for i := range groupCount {
-		mod := &big.Int{}
-		div := &big.Int{}
-		div.DivMod(bn, big_thausand, mod)
-		groups[i] = uint16(mod.Uint64())
-		bn = div
+// addOrder adds scale words of group index i > 0
+func addOrder(l *tokens.List, i int) {
+	if i < len(big_words) {
+		l.Add(num2words.TokenScale, big_words[i], i)
+		return
 	}
-	m := digitCount % 3
-	if m > 0 {
-		groups = append(groups, uint16(bn.Uint64()))
+	d := i / 3
+	m := i % 3
+	if m != 0 {
+		l.Add(num2words.TokenScale, big_words[m], i)
+	}
+	for j := range d {
+		if j > 0 || m != 0 {
+			l.Space(zwnj)
+		}
+		l.Add(num2words.TokenScale, big_words[3], i)
 	}
-	return groups
 }
 
-func joinReversed(groups []string, sep string) string {
-	r_groups := make([]string, len(groups))
-	n := len(groups)
-	for i := range n {
-		r_groups[n-i-1] = groups[i]
+// addGroup adds words of non-zero group p with index i
+func addGroup(l *tokens.List, p uint16, i int, o *num2words.Options) {
+	if i == 0 {
+		addSmall(l, p, i, o)
+		return
+	}
+	if i > 1 || p != 1 {
+		addSmall(l, p, i, o)
+		l.Space(" ")
 	}
-	return strings.Join(r_groups, sep)
+	addOrder(l, i)
 }
 
 // n >= 1000
-func convertLarge(groups []uint16) string {
-	k := len(groups)
-	w_groups := []string{}
-	for i := range k {
+func addLarge(l *tokens.List, groups []uint16, o *num2words.Options) {
+	start := l.Len()
+	for i := len(groups) - 1; i >= 0; i-- {
 		p := groups[i]
 		if p == 0 {
 			continue
 		}
-		if i == 0 {
-			w_groups = append(w_groups, convertSmall(p))
-			continue
-		}
-		order := ""
-		if i < len(big_words) {
-			order = big_words[i]
-		} else {
-			order = ""
-			d := i / 3
-			m := i % 3
-			t9 := big_words[3]
-			for j := range d {
-				if j > 0 {
-					order += zwnj
-				}
-				order += t9
-			}
-			if m != 0 {
-				if order != "" {
-					order = zwnj + order
-				}
-				order = big_words[m] + order
-			}
-		}
-		var w_group string
-		if i == 1 && p == 1 {
-			w_group = order
-		} else {
-			w_group = convertSmall(p) + " " + order
+		if l.Len() > start {
+			l.Conjunction(o.GroupSeparator, i)
 		}
-		w_groups = append(w_groups, w_group)
+		addGroup(l, p, i, o)
+	}
+	if l.Len() == start {
+		l.Add(num2words.TokenDigit, fa_zero, 0)
 	}
-	return joinReversed(w_groups, fa_and)
 }
 
 // num < 1000
-func convertSmall(num uint16) string {
+func addSmall(l *tokens.List, num uint16, group int, o *num2words.Options) {
 	{
 		word, ok := small_words[num]
 		if ok {
-			return word
+			l.Add(tokens.SmallKind(num), word, group)
+			return
 		}
 	}
 	ones := num % 10
 	tens := (num % 100) / 10
 	hundreds := num / 100
-	result := ""
 	if hundreds != 0 {
 		word, ok := small_words[hundreds*100]
-		if ok {
-			result += word
-		} else {
-			result += small_words[hundreds] + small_words[100]
+		if !ok {
+			word = small_words[hundreds] + small_words[100]
 		}
+		l.Add(num2words.TokenHundred, word, group)
 		if tens != 0 || ones != 0 {
-			result += fa_and
+			l.Conjunction(o.Conjunction, group)
 		}
 	}
 	if tens != 0 {
 		word, ok := small_words[num%100]
 		if ok {
-			result += word
-			return result // OK, Done
+			l.Add(tokens.SmallKind(num%100), word, group)
+			return // OK, Done
 		}
-		result += small_words[tens*10]
+		l.Add(num2words.TokenTens, small_words[tens*10], group)
 		if ones != 0 {
-			result += fa_and
+			l.Conjunction(o.Conjunction, group)
 		}
 	}
 	if ones != 0 {
-		result += small_words[ones]
+		l.Add(num2words.TokenDigit, small_words[ones], group)
 	}
-	return result
 }
 
-// ConvertString: only for non-negative integers
-func ConvertString(str string) (string, error) {
+func newOptions(opts []num2words.Option) *num2words.Options {
+	return num2words.NewOptions(defaultOptions, opts)
+}
+
+func addDigits(l *tokens.List, str string, o *num2words.Options) error {
 	if len(str) <= 3 { // n <= 999
 		n_i64, err := strconv.ParseUint(str, 10, 64)
 		if err != nil {
-			return "", err
+			return err
 		}
-		return convertSmall(uint16(n_i64)), nil
+		addSmall(l, uint16(n_i64), 0, o)
+		return nil
 	}
 	// n >= 1000
 	groups, err := extractGroupsByString(str)
+	if err != nil {
+		return err
+	}
+	addLarge(l, groups, o)
+	return nil
+}
+
+func stringTokens(str string, o *num2words.Options) (*tokens.List, error) {
+	n, err := numstr.Parse(str, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	l := &tokens.List{}
+	if n.Negative && n.Digits != "0" {
+		addSign(l)
+	}
+	err = addDigits(l, n.Digits, o)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// ConvertStringTokens is like ConvertString, but returns tokens
+func ConvertStringTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	l, err := stringTokens(str, o)
+	if err != nil {
+		return nil, err
+	}
+	return l.Result(o), nil
+}
+
+// ConvertString: str may have a sign and thousands separators, unless
+// strict option is enabled
+func ConvertString(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertStringTokens(str, opts...)
 	if err != nil {
 		return "", err
 	}
-	return convertLarge(groups), nil
+	return num2words.JoinTokens(result), nil
 }
 
-// ConvertBigInt: only for non-negative integers
-func ConvertBigInt(bn *big.Int) string {
-	digitCount := bigIntCountDigits(bn.Bytes())
-	if digitCount <= 3 { // n <= 999
-		return convertSmall(uint16(bn.Uint64()))
+// addBigInt adds words of absolute value of bn
+func addBigInt(l *tokens.List, bn *big.Int, o *num2words.Options) {
+	b_groups := groups.FromBigInt(bn)
+	if len(b_groups) == 1 { // n <= 999
+		addSmall(l, b_groups[0], 0, o)
+		return
 	}
 	// n >= 1000
-	return convertLarge(extractGroupsByBigInt(bn, digitCount))
+	addLarge(l, b_groups, o)
 }
 
-func ConvertBigIntSigned(bn *big.Int) string {
+// ConvertBigIntTokens is like ConvertBigIntSigned, but returns tokens
+func ConvertBigIntTokens(bn *big.Int, opts ...num2words.Option) []num2words.Token {
+	o := newOptions(opts)
+	l := &tokens.List{}
 	if bn.Cmp(big_zero) < 0 {
-		return "منفی " + ConvertBigInt(bn.Abs(bn))
+		addSign(l)
+	}
+	addBigInt(l, bn, o)
+	return l.Result(o)
+}
+
+// ConvertBigInt: only for non-negative integers
+func ConvertBigInt(bn *big.Int, opts ...num2words.Option) string {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	addBigInt(l, bn, o)
+	return num2words.JoinTokens(l.Result(o))
+}
+
+func ConvertBigIntSigned(bn *big.Int, opts ...num2words.Option) string {
+	return num2words.JoinTokens(ConvertBigIntTokens(bn, opts...))
+}
+
+func addUint64(l *tokens.List, n uint64, o *num2words.Options) {
+	if n < 1000 {
+		addSmall(l, uint16(n), 0, o)
+		return
 	}
-	return ConvertBigInt(bn)
+	addLarge(l, groups.FromUint64(n), o)
 }
 
-func addOrdinalSuffix(result string) string {
-	if strings.HasSuffix(result, "ی") {
-		return result + zwnj + "ام"
+// Convert: for any native integer type, signed or unsigned
+func Convert[T num2words.Integer](n T, opts ...num2words.Option) string {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	abs, negative := groups.Abs(n)
+	if negative {
+		addSign(l)
 	}
-	if strings.HasSuffix(result, "سه") {
-		resultRunes := []rune(result)
-		return string(resultRunes[:len(resultRunes)-1]) + "وم"
+	addUint64(l, abs, o)
+	return num2words.JoinTokens(l.Result(o))
+}
+
+// addOrdinalSuffix adds ordinal suffix after the last token
+func addOrdinalSuffix(l *tokens.List) {
+	last := l.Last()
+	group := last.Group
+	switch {
+	case strings.HasSuffix(last.Text, "ی"):
+		l.Space(zwnj)
+		l.Add(num2words.TokenOrdinalSuffix, "ام", group)
+	case strings.HasSuffix(last.Text, "سه"):
+		last.Text = strings.TrimSuffix(last.Text, "ه") + "و"
+		l.Add(num2words.TokenOrdinalSuffix, "م", group)
+	default:
+		l.Add(num2words.TokenOrdinalSuffix, "م", group)
 	}
-	return result + "م"
 }
 
-func ConvertOrdinalString(str string) (string, error) {
-	if str == "1" {
-		return fa_first, nil
+// ConvertOrdinalStringTokens is like ConvertOrdinalString, but returns tokens
+func ConvertOrdinalStringTokens(str string, opts ...num2words.Option) ([]num2words.Token, error) {
+	o := newOptions(opts)
+	n, err := numstr.Parse(str, o.Strict)
+	if err != nil {
+		return nil, err
+	}
+	if n.Negative {
+		return nil, n.SignError()
 	}
-	if str == "10" {
-		return fa_tenth, nil
+	l := &tokens.List{}
+	switch n.Digits {
+	case "1":
+		l.Add(num2words.TokenDigit, fa_first, 0)
+	case "10":
+		l.Add(num2words.TokenTeen, fa_tenth, 0)
+	default:
+		err := addDigits(l, n.Digits, o)
+		if err != nil {
+			return nil, err
+		}
+		addOrdinalSuffix(l)
 	}
-	result, err := ConvertString(str)
+	return l.Result(o), nil
+}
+
+func ConvertOrdinalString(str string, opts ...num2words.Option) (string, error) {
+	result, err := ConvertOrdinalStringTokens(str, opts...)
 	if err != nil {
 		return "", err
 	}
-	return addOrdinalSuffix(result), nil
+	return num2words.JoinTokens(result), nil
 }
 
-func ConvertOrdinalBigInt(bn *big.Int) string {
-	if bn.Cmp(big_one) == 0 {
-		return fa_first
+// ConvertOrdinalBigIntTokens is like ConvertOrdinalBigInt, but returns tokens
+func ConvertOrdinalBigIntTokens(bn *big.Int, opts ...num2words.Option) []num2words.Token {
+	o := newOptions(opts)
+	l := &tokens.List{}
+	switch {
+	case bn.Cmp(big_one) == 0:
+		l.Add(num2words.TokenDigit, fa_first, 0)
+	case bn.Cmp(big_ten) == 0:
+		l.Add(num2words.TokenTeen, fa_tenth, 0)
+	default:
+		addBigInt(l, bn, o)
+		addOrdinalSuffix(l)
 	}
-	if bn.Cmp(big_ten) == 0 {
-		return fa_tenth
-	}
-	result := ConvertBigInt(bn)
-	return addOrdinalSuffix(result)
+	return l.Result(o)
+}
+
+func ConvertOrdinalBigInt(bn *big.Int, opts ...num2words.Option) string {
+	return num2words.JoinTokens(ConvertOrdinalBigIntTokens(bn, opts...))
 }
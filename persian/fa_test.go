@@ -3,13 +3,16 @@ package persian_test
 import (
 	"bufio"
 	"compress/gzip"
+	"errors"
 	"log"
+	"math"
 	"math/big"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
 	"github.com/ilius/num2words/persian"
 )
 
@@ -114,6 +117,98 @@ func TestConvertBigIntSigned(t *testing.T) {
 	}
 }
 
+func TestConvertStringOptions(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, words string, opts ...num2words.Option) {
+		actual, err := persian.ConvertString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(actual, words)
+	}
+	test("1234", "هزار و دویست و سی و چهار")
+	test("1234", "هزار، دویست و سی و چهار", num2words.WithGroupSeparator("، "))
+	test("1234", "هزار و دویست سی چهار", num2words.WithConjunction(" "))
+	test("1234", "هزار و دویست و سی و چهار", num2words.WithCase(num2words.CaseUpper))
+}
+
+func TestConvertStringErrors(t *testing.T) {
+	is := is.New(t).Lax()
+	_, err := persian.ConvertString("")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+
+	_, err = persian.ConvertString("1234x6")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	var charErr *num2words.InvalidCharError
+	if is.True(errors.As(err, &charErr)) {
+		is.Equal(charErr.Offset, 4)
+		is.Equal(charErr.Char, 'x')
+	}
+
+	_, err = persian.ConvertString("12")
+	is.NotErr(err)
+}
+
+func TestConvertStringNormalize(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, words string) {
+		actual, err := persian.ConvertString(str)
+		is.Msg("num=%#v", str).NotErr(err)
+		is.Msg("num=%#v", str).Equal(actual, words)
+	}
+	test("-42", "منفی چهل و دو")
+	test("1,001", "هزار و یک")
+	test("0000", "صفر")
+	test("۱۲۳۴", "هزار و دویست و سی و چهار")
+	test("١٢٣٤", "هزار و دویست و سی و چهار")
+	test("۱٬۰۰۱", "هزار و یک")
+	test("۱2٣", "صد و بیست و سه")
+}
+
+func TestConvertOrdinalStringDigits(t *testing.T) {
+	is := is.New(t)
+	words, err := persian.ConvertOrdinalString("۲۳")
+	is.NotErr(err)
+	is.Equal(words, "بیست و سوم")
+}
+
+func TestConvertOrdinalStringSign(t *testing.T) {
+	is := is.New(t).Lax()
+	words, err := persian.ConvertOrdinalString("+1")
+	is.NotErr(err)
+	is.Equal(words, "اول")
+	words, err = persian.ConvertOrdinalString("0010")
+	is.NotErr(err)
+	is.Equal(words, "دهم")
+	_, err = persian.ConvertOrdinalString("-3")
+	is.True(errors.Is(err, num2words.ErrUnsupportedSign))
+}
+
+func TestConvertGeneric(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		if !tc.BigInt.IsInt64() {
+			continue
+		}
+		n := tc.BigInt.Int64()
+		is.Msg("num=%v", n).Equal(persian.Convert(n), tc.Words)
+		is.Msg("num=%v", n).Equal(persian.Convert(uint64(n)), tc.Words)
+		bn_neg := big.NewInt(-n)
+		is.Msg("num=%v", -n).Equal(persian.Convert(-n), persian.ConvertBigIntSigned(bn_neg))
+	}
+	testLimit := func(n string, words string) {
+		bn := &big.Int{}
+		bn.SetString(n, 10)
+		is.Msg("num=%v", n).Equal(words, persian.ConvertBigIntSigned(bn))
+	}
+	testLimit("-9223372036854775808", persian.Convert(int64(math.MinInt64)))
+	testLimit("9223372036854775807", persian.Convert(int64(math.MaxInt64)))
+	testLimit("18446744073709551615", persian.Convert(uint64(math.MaxUint64)))
+	testLimit("-128", persian.Convert(int8(math.MinInt8)))
+	testLimit("255", persian.Convert(uint8(255)))
+	testLimit("-2147483648", persian.Convert(int32(math.MinInt32)))
+	testLimit("0", persian.Convert(0))
+	testLimit("-7", persian.Convert(-7))
+}
+
 func Benchmark_convert_string_bigInt(b *testing.B) {
 	b.Run("string", func(b *testing.B) {
 		for _, tc := range testData {
@@ -0,0 +1,141 @@
+package persian
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/wordparse"
+)
+
+const fa_and_word = "و"
+
+var (
+	// parse_words maps words of numbers below 1000 to their values, with
+	// common variants like "یکصد" and "چهار صد"
+	parse_words = map[string]uint16{
+		"هیجده": 18,
+	}
+
+	// ordinal_cardinals maps irregular ordinals (without suffix) to
+	// cardinals
+	ordinal_cardinals = map[string]string{
+		fa_first: small_words[1],
+		"نخست":   small_words[1],
+		"سو":     small_words[3], // "سوم"
+	}
+
+	// scale_exponents maps scale words to their exponent of 10
+	scale_exponents = map[string]int{}
+
+	// Arabic letters used instead of Persian letters
+	arabic_letters = strings.NewReplacer(
+		"ي", "ی",
+		"ى", "ی",
+		"ك", "ک",
+	)
+
+	// ordinal suffixes, longer ones first
+	ordinal_suffixes = []string{"امین", "مین", "ام", "م"}
+
+	parse_language = &wordparse.Language{
+		Small:  parse_words,
+		Scales: scale_exponents,
+		// "میلیارد" is the only word repeated in larger scale words
+		Compound:   9,
+		And:        []string{fa_and_word},
+		Negative:   fa_negative,
+		CardinalOf: cardinalOf,
+	}
+)
+
+func init() {
+	for num, word := range small_words {
+		parse_words[word] = num
+	}
+	for h := uint16(1); h < 10; h++ {
+		parse_words[small_words[h]+small_words[100]] = h * 100
+	}
+	for i, word := range big_words[1:] {
+		scale_exponents[word] = 3 * (i + 1)
+	}
+}
+
+func isWordSeparator(c rune) bool {
+	return unicode.IsSpace(c) || c == '\u200c' || c == '،'
+}
+
+// splitWords splits str by white space, ZWNJ and "،"
+// Arabic Yeh and Kaf are normalized to Persian letters, which have the
+// same length in UTF-8, so offsets are kept
+func splitWords(str string) []wordparse.Word {
+	normal := arabic_letters.Replace(str)
+	var words []wordparse.Word
+	start := -1
+	flush := func(end int) {
+		if start >= 0 {
+			words = append(words, wordparse.Word{
+				Text:   normal[start:end],
+				Orig:   str[start:end],
+				Offset: start,
+			})
+		}
+		start = -1
+	}
+	for i, c := range normal {
+		if isWordSeparator(c) {
+			flush(i)
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	flush(len(normal))
+	return words
+}
+
+// cardinalOf removes ordinal suffix of word, if it has one
+func cardinalOf(word string) string {
+	if _, ok := parse_words[word]; ok {
+		return word
+	}
+	if cardinal, ok := ordinal_cardinals[word]; ok {
+		return cardinal
+	}
+	for _, suffix := range ordinal_suffixes {
+		cardinal, ok := strings.CutSuffix(word, suffix)
+		if !ok {
+			continue
+		}
+		if irregular, ok := ordinal_cardinals[cardinal]; ok {
+			return irregular
+		}
+		if _, ok := parse_words[cardinal]; ok {
+			return cardinal
+		}
+		if _, ok := scale_exponents[cardinal]; ok {
+			return cardinal
+		}
+	}
+	return word
+}
+
+// Parse reads a number written in persian words, like the output of
+// ConvertString: "دویست و سی و پنج هزار", "یک میلیون و دویست"
+// It accepts missing or extra "و", ZWNJ or space between words, Arabic Yeh
+// and Kaf, and ordinal forms like "بیست و سوم", "سی‌ام" and "دومین"
+// Options are accepted like english.Parse, and not used by this language
+// Returns *num2words.WordError for words that are not expected
+func Parse(str string, opts ...num2words.Option) (*big.Int, error) {
+	words := splitWords(str)
+	if n := len(words); n > 1 {
+		switch words[n-1].Text {
+		case "ام", "امین":
+			// "سی‌ام"
+			words = words[:n-1]
+		}
+	}
+	return parse_language.Parse(str, words)
+}
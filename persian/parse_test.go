@@ -0,0 +1,154 @@
+package persian_test
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/persian"
+)
+
+func TestParse(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string) {
+		n, err := persian.Parse(str)
+		if !is.Msg("str=%#v", str).NotErr(err) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(n.String(), expected)
+	}
+	test("صفر", "0")
+	test("یک", "1")
+	test("بیست و سه", "23")
+	test("صد و پنج", "105")
+	test("دویست و سی و پنج هزار", "235000")
+	test("یک میلیون و دویست", "1000200")
+	test("هزار و یک", "1001")
+	test("یک هزار و یک", "1001")
+	test("منفی پنج", "-5")
+
+	// missing and extra "و"
+	test("دویست سی پنج هزار", "235000")
+	test("یک میلیون دویست", "1000200")
+	test("و بیست و سه", "23")
+	test("بیست و و سه", "23")
+
+	// hundreds
+	test("یکصد", "100")
+	test("یک صد", "100")
+	test("چهارصد", "400")
+	test("چهار صد و پنج", "405")
+	test("پانصد", "500")
+	test("هیجده", "18")
+
+	// ZWNJ and space
+	test("یک میلیون‌میلیارد", "1000000000000000")
+	test("یک میلیون میلیارد", "1000000000000000")
+	test("ده هزار‌میلیارد‌میلیارد", "10000000000000000000000")
+	test("سی میلیون هزار", "30001000")
+	test("بیست‌و‌سه", "23")
+
+	// Arabic Yeh and Kaf
+	test("يك", "1")
+	test("سي و يك", "31")
+	test("چهار ميليارد", "4000000000")
+
+	// ordinals
+	test("اول", "1")
+	test("یکم", "1")
+	test("نخست", "1")
+	test("دوم", "2")
+	test("سوم", "3")
+	test("دهم", "10")
+	test("بیست و سوم", "23")
+	test("سی‌ام", "30")
+	test("سی ام", "30")
+	test("سیام", "30")
+	test("دومین", "2")
+	test("سی‌امین", "30")
+	test("صدم", "100")
+	test("هزارم", "1000")
+	test("یک میلیونم", "1000000")
+	test("صفرم", "0")
+
+	// digits
+	test("۲ میلیون", "2000000")
+	test("2 میلیون و ۵۰۰ هزار", "2500000")
+}
+
+func TestParseError(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, offset int, word string) {
+		_, err := persian.Parse(str)
+		is.Msg("str=%#v", str).True(errors.Is(err, num2words.ErrInvalidWord))
+		var wordErr *num2words.WordError
+		if !is.Msg("str=%#v", str).True(errors.As(err, &wordErr)) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(wordErr.Input, str)
+		is.Msg("str=%#v", str).Equal(wordErr.Offset, offset)
+		is.Msg("str=%#v", str).Equal(wordErr.Word, word)
+	}
+	test("سیب", 0, "سیب")
+	test("پنج پنج", len("پنج "), "پنج")
+	test("بیست سی", len("بیست "), "سی")
+	test("بیست یازده", len("بیست "), "یازده")
+	test("صد دویست", len("صد "), "دویست")
+	test("دو و هزار", len("دو و "), "هزار")
+	test("دو هزار و سه میلیون", len("دو هزار و سه "), "میلیون")
+	test("هزار و هزار", len("هزار و "), "هزار")
+	test("هزار هزار", len("هزار "), "هزار")
+	test("میلیون هزار‌میلیارد", len("میلیون هزار‌"), "میلیارد")
+	test("صفر و یک", len("صفر و "), "یک")
+	test("اول و دوم", 0, "اول")
+	test("يك سيب", len("يك "), "سيب")
+	test("منفی", 0, "منفی")
+	test("منفی منفی یک", len("منفی "), "منفی")
+	test("پنج و", len("پنج "), "و")
+	test("بیست و سه و و", len("بیست و سه و "), "و")
+
+	_, err := persian.Parse(" ")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	is := is.New(t).Lax()
+	rng := rand.New(rand.NewSource(1))
+	optionSets := [][]num2words.Option{
+		nil,
+		{num2words.WithConjunction(" ")},
+		{num2words.WithGroupSeparator(" ")},
+		{num2words.WithGroupSeparator("، ")},
+	}
+	check := func(words string, expected *big.Int, opts ...num2words.Option) {
+		n, err := persian.Parse(words, opts...)
+		if !is.Msg("words=%#v", words).NotErr(err) {
+			return
+		}
+		is.Msg("words=%#v", words).Equal(n.String(), expected.String())
+	}
+	for range 300 {
+		digits := make([]byte, 1+rng.Intn(40))
+		for i := range digits {
+			digits[i] = byte('0' + rng.Intn(10))
+			if rng.Intn(2) == 0 {
+				digits[i] = '0'
+			}
+		}
+		bn, _ := new(big.Int).SetString(string(digits), 10)
+		for _, opts := range optionSets {
+			check(persian.ConvertBigInt(bn, opts...), bn, opts...)
+			check(persian.ConvertOrdinalBigInt(bn, opts...), bn, opts...)
+			words := persian.ConvertBigIntSigned(new(big.Int).Neg(bn), opts...)
+			check(words, new(big.Int).Neg(bn), opts...)
+			check(strings.ReplaceAll(words, "‌", " "), new(big.Int).Neg(bn))
+		}
+	}
+	for _, tc := range testData {
+		check(tc.Words, tc.BigInt)
+	}
+}
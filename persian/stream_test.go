@@ -0,0 +1,51 @@
+package persian_test
+
+import (
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/persian"
+)
+
+func convertReader(t *testing.T, r io.Reader, opts ...num2words.Option) string {
+	t.Helper()
+	buf := &strings.Builder{}
+	err := persian.ConvertReader(buf, r, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestConvertReader(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		is.Msg("num=%v", tc.String).Equal(
+			convertReader(t, strings.NewReader(tc.String)),
+			tc.Words,
+		)
+	}
+	is.Equal(
+		convertReader(t, struct{ io.Reader }{strings.NewReader("0001002\n")}),
+		persian.ConvertBigInt(big.NewInt(1002)),
+	)
+}
+
+func TestConvertReaderLarge(t *testing.T) {
+	is := is.New(t).Lax()
+	str := strings.Repeat("9876543210", 300/10) + "123"
+	bn := &big.Int{}
+	bn.SetString(str, 10)
+	is.Equal(
+		convertReader(t, strings.NewReader(str)),
+		persian.ConvertBigInt(bn),
+	)
+	is.Equal(
+		convertReader(t, strings.NewReader(str), num2words.WithCase(num2words.CaseUpper)),
+		persian.ConvertBigInt(bn, num2words.WithCase(num2words.CaseUpper)),
+	)
+}
@@ -0,0 +1,33 @@
+package persian_test
+
+import (
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/persian"
+)
+
+func TestConvertStringTokens(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		tokens, err := persian.ConvertStringTokens(tc.String)
+		is.NotErr(err)
+		is.Msg("num=%v", tc.String).Equal(num2words.JoinTokens(tokens), tc.Words)
+		is.Msg("num=%v", tc.String).Equal(
+			num2words.JoinTokens(persian.ConvertBigIntTokens(tc.BigInt)),
+			tc.Words,
+		)
+	}
+}
+
+func TestConvertOrdinalTokens(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range ordinalTestData {
+		tokens, err := persian.ConvertOrdinalStringTokens(tc.String)
+		is.NotErr(err)
+		is.Msg("num=%v", tc.String).Equal(num2words.JoinTokens(tokens), tc.Words)
+		tokens = persian.ConvertOrdinalBigIntTokens(tc.BigInt)
+		is.Msg("num=%v", tc.String).Equal(num2words.JoinTokens(tokens), tc.Words)
+	}
+}
@@ -0,0 +1,69 @@
+package num2words
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Converter{}
+)
+
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+// Register makes a Converter available for the given BCP-47 tag.
+// It is called from init function of language packages, and panics
+// if the tag is empty, or conv is nil, or tag is already registered
+func Register(tag string, conv Converter) {
+	key := normalizeTag(tag)
+	if key == "" {
+		panic("num2words: Register with empty tag")
+	}
+	if conv == nil {
+		panic("num2words: Register converter is nil for " + tag)
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, dup := registry[key]; dup {
+		panic("num2words: Register called twice for " + tag)
+	}
+	registry[key] = conv
+}
+
+// For returns the Converter registered for BCP-47 tag.
+// Matching is case-insensitive, and if there is no exact match, subtags are
+// removed from the end until one is found, so "fa-IR" falls back to "fa"
+func For(tag string) (Converter, error) {
+	key := normalizeTag(tag)
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for key != "" {
+		conv, ok := registry[key]
+		if ok {
+			return conv, nil
+		}
+		i := strings.LastIndexByte(key, '-')
+		if i < 0 {
+			break
+		}
+		key = key[:i]
+	}
+	return nil, fmt.Errorf("%w: %#v", ErrUnknownLanguage, tag)
+}
+
+// Languages returns sorted list of registered tags
+func Languages() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	tags := make([]string, 0, len(registry))
+	for tag := range registry {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
@@ -0,0 +1,106 @@
+package num2words_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	_ "github.com/ilius/num2words/arabic"
+	_ "github.com/ilius/num2words/english"
+	_ "github.com/ilius/num2words/persian"
+	_ "github.com/ilius/num2words/tajik"
+)
+
+func TestLanguages(t *testing.T) {
+	is := is.New(t)
+	is.Equal(num2words.Languages(), []string{"ar", "en", "fa", "tg"})
+}
+
+func TestFor(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(tag string, expectedTag string, words string) {
+		conv, err := num2words.For(tag)
+		if !is.NotErr(err) {
+			return
+		}
+		is.Equal(conv.Tag(), expectedTag)
+		actual, err := conv.ConvertString("1234")
+		is.NotErr(err)
+		is.Msg("tag=%v", tag).Equal(actual, words)
+	}
+	test("en", "en", "One Thousand, Two Hundred Thirty Four")
+	test("en-US", "en", "One Thousand, Two Hundred Thirty Four")
+	test("EN_gb", "en", "One Thousand, Two Hundred Thirty Four")
+	test("fa", "fa", "هزار و دویست و سی و چهار")
+	test("fa-IR", "fa", "هزار و دویست و سی و چهار")
+	test("tg-Cyrl-TJ", "tg", "ҳазору дусаду сӣу чор")
+	test("ar", "ar", "ألف و مئتان و أربعة و ثلاثون")
+}
+
+func TestForUnknown(t *testing.T) {
+	is := is.New(t)
+	_, err := num2words.For("xx-YY")
+	is.True(errors.Is(err, num2words.ErrUnknownLanguage))
+	_, err = num2words.For("")
+	is.True(errors.Is(err, num2words.ErrUnknownLanguage))
+}
+
+func TestCapabilities(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tag := range num2words.Languages() {
+		conv, err := num2words.For(tag)
+		is.NotErr(err)
+		caps := conv.Capabilities()
+		is.Msg("tag=%v", tag).True(caps.Has(num2words.Cardinal))
+
+		_, err = conv.ConvertBigIntSigned(big.NewInt(-5))
+		is.Msg("tag=%v", tag).Equal(
+			errors.Is(err, num2words.ErrUnsupported),
+			!caps.Has(num2words.Signed),
+		)
+
+		_, err = conv.ConvertOrdinalString("5")
+		is.Msg("tag=%v", tag).Equal(
+			errors.Is(err, num2words.ErrUnsupported),
+			!caps.Has(num2words.Ordinal),
+		)
+		_, err = conv.ConvertOrdinalBigInt(big.NewInt(5))
+		is.Msg("tag=%v", tag).Equal(
+			errors.Is(err, num2words.ErrUnsupported),
+			!caps.Has(num2words.Ordinal),
+		)
+	}
+}
+
+func TestNegativeUnsigned(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tag := range num2words.Languages() {
+		conv, err := num2words.For(tag)
+		is.NotErr(err)
+		_, err = conv.ConvertBigInt(big.NewInt(-5))
+		is.Msg("tag=%v", tag).True(errors.Is(err, num2words.ErrUnsupportedSign))
+		var signErr *num2words.SignError
+		if is.Msg("tag=%v", tag).True(errors.As(err, &signErr)) {
+			is.Msg("tag=%v", tag).Equal(signErr.Input, "-5")
+			is.Msg("tag=%v", tag).Equal(signErr.Sign, '-')
+		}
+		_, err = conv.ConvertString("-5")
+		is.Msg("tag=%v", tag).NotErr(err)
+		_, err = conv.ConvertOrdinalBigInt(big.NewInt(-5))
+		if conv.Capabilities().Has(num2words.Ordinal) {
+			is.Msg("tag=%v", tag).True(errors.Is(err, num2words.ErrUnsupportedSign))
+		} else {
+			is.Msg("tag=%v", tag).True(errors.Is(err, num2words.ErrUnsupported))
+		}
+		_, err = conv.ConvertBigInt(big.NewInt(0))
+		is.Msg("tag=%v", tag).NotErr(err)
+	}
+}
+
+func TestCapabilityString(t *testing.T) {
+	is := is.New(t)
+	is.Equal((num2words.Cardinal | num2words.Ordinal).String(), "cardinal|ordinal")
+	is.Equal(num2words.Capability(0).String(), "")
+}
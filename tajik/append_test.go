@@ -0,0 +1,70 @@
+package tajik_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words/tajik"
+)
+
+func TestAppendWords(t *testing.T) {
+	is := is.New(t).Lax()
+	buf := make([]byte, 0, 1024)
+	for _, tc := range testData {
+		if !tc.BigInt.IsUint64() {
+			continue
+		}
+		buf = tajik.AppendWords(buf[:0], tc.BigInt.Uint64())
+		is.Msg("num=%v", tc.String).Equal(string(buf), tc.Words)
+	}
+	for _, n := range []uint64{
+		1_000_000_000_000,
+		1_001_000_000_000_000,
+		math.MaxInt64,
+		math.MaxUint64,
+	} {
+		bn := new(big.Int).SetUint64(n)
+		is.Msg("num=%v", n).Equal(
+			string(tajik.AppendWords(nil, n)),
+			tajik.ConvertBigInt(bn),
+		)
+	}
+	is.Equal(string(tajik.AppendWords([]byte("n="), 21)), "n=бисту як")
+}
+
+func TestAppendWordsAllocs(t *testing.T) {
+	is := is.New(t)
+	buf := make([]byte, 0, 1024)
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, tc := range testData {
+			if !tc.BigInt.IsUint64() {
+				continue
+			}
+			buf = tajik.AppendWords(buf[:0], tc.BigInt.Uint64())
+		}
+		buf = tajik.AppendWords(buf[:0], math.MaxUint64)
+	})
+	is.Equal(allocs, 0.0)
+}
+
+func BenchmarkAppendWords(b *testing.B) {
+	nums := make([]uint64, 0, len(testData))
+	for _, tc := range testData {
+		nums = append(nums, tc.BigInt.Uint64())
+	}
+	buf := make([]byte, 0, 1024)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range b.N {
+		buf = tajik.AppendWords(buf[:0], nums[i%len(nums)])
+	}
+}
+
+func BenchmarkConvertBigInt(b *testing.B) {
+	b.ReportAllocs()
+	for i := range b.N {
+		_ = tajik.ConvertBigInt(testData[i%len(testData)].BigInt)
+	}
+}
@@ -0,0 +1,29 @@
+package tajik_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/tajik"
+)
+
+func TestConvertDigits(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string, opts ...num2words.Option) {
+		words, err := tajik.ConvertDigits(str, opts...)
+		is.NotErr(err)
+		is.Msg("str=%#v", str).Equal(words, expected)
+	}
+	test("415-01", "чор як панҷ, сифр як")
+	test("12345", "як ду се, чор панҷ", num2words.WithDigitGroups(3))
+	test("۰۰۷", "сифр сифр ҳафт")
+	// not used by this language
+	test("007", "сифр сифр ҳафт", num2words.WithOh(true))
+
+	_, err := tajik.ConvertDigits("12a")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	_, err = tajik.ConvertDigits("007", num2words.WithDigitRepeat(true))
+	is.True(errors.Is(err, num2words.ErrUnsupported))
+}
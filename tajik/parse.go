@@ -0,0 +1,157 @@
+package tajik
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/internal/wordparse"
+)
+
+// "у" is written after the previous word: "бисту се"
+const tg_and_suffix = "у"
+
+var (
+	// parse_words maps words of numbers below 1000 to their values, with
+	// alternate spellings
+	parse_words = map[string]uint16{
+		"чаҳор":    4,
+		"чаҳордаҳ": 14,
+		"панҷох":   50,
+	}
+
+	// scale_exponents maps scale words to their exponent of 10
+	scale_exponents = map[string]int{}
+
+	// forms of "у" written after the previous word, "ю" and "ву" come
+	// after vowels: "сию", "дуву"
+	and_suffixes = []string{"ву", tg_and_suffix, "ю"}
+
+	// ordinal suffixes, longer ones first
+	ordinal_suffixes = []string{"вум", "юм", "ум"}
+
+	parse_language = &wordparse.Language{
+		Small:  parse_words,
+		Scales: scale_exponents,
+		// "миллиард" is the only word repeated in larger scale words
+		Compound: 9,
+		// "у" or "ва" written apart
+		And:        []string{tg_and_suffix, "ва"},
+		Negative:   strings.ToLower(tg_negative),
+		CardinalOf: cardinalOf,
+	}
+)
+
+func init() {
+	for num, word := range small_words {
+		parse_words[word] = num
+	}
+	for h := uint16(1); h < 10; h++ {
+		parse_words[small_words[h]+small_words[100]] = h * 100
+	}
+	parse_words["чаҳор"+small_words[100]] = 400
+	for i, word := range big_words[1:] {
+		scale_exponents[word] = 3 * (i + 1)
+	}
+}
+
+// isNumberWord returns true if word is a known lower case cardinal word
+func isNumberWord(word string) bool {
+	if _, ok := parse_words[word]; ok {
+		return true
+	}
+	_, ok := scale_exponents[word]
+	return ok
+}
+
+// numberWord returns the known form of lower case cardinal word, which
+// may be written with "и" instead of "ӣ": "си" => "сӣ"
+func numberWord(word string) (string, bool) {
+	if isNumberWord(word) {
+		return word, true
+	}
+	if stem, ok := strings.CutSuffix(word, "и"); ok && isNumberWord(stem+"ӣ") {
+		return stem + "ӣ", true
+	}
+	return word, false
+}
+
+// splitWords splits str by white space and commas, and cuts the "у"
+// suffix of number words
+func splitWords(str string) []wordparse.Word {
+	var words []wordparse.Word
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		w := wordparse.Word{
+			Text:   strings.ToLower(str[start:end]),
+			Orig:   str[start:end],
+			Offset: start,
+		}
+		if word, ok := numberWord(w.Text); ok {
+			w.Text = word
+		} else {
+			// "бисту", "сию", but not "ду"
+			for _, suffix := range and_suffixes {
+				text, ok := strings.CutSuffix(w.Text, suffix)
+				if !ok {
+					continue
+				}
+				if word, ok := numberWord(text); ok {
+					w.Text = word
+					w.And = true
+					break
+				}
+			}
+		}
+		words = append(words, w)
+		start = -1
+	}
+	for i, c := range str {
+		if unicode.IsSpace(c) || c == ',' {
+			flush(i)
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	flush(len(str))
+	return words
+}
+
+// cardinalOf removes ordinal suffix of lower case word, if it has one:
+// "якум" => "як", "севум" => "се", "сюм" and "сиюм" => "сӣ"
+func cardinalOf(word string) string {
+	if isNumberWord(word) {
+		return word
+	}
+	for _, suffix := range ordinal_suffixes {
+		cardinal, ok := strings.CutSuffix(word, suffix)
+		if !ok {
+			continue
+		}
+		if cardinal, ok := numberWord(cardinal); ok {
+			return cardinal
+		}
+		if isNumberWord(cardinal + "ӣ") {
+			// "сюм"
+			return cardinal + "ӣ"
+		}
+	}
+	return word
+}
+
+// Parse reads a number written in tajik Cyrillic words, like the output of
+// ConvertString: "дусаду сию панҷ ҳазор", "як миллиону дусад"
+// It accepts ordinal forms with "-ум", "-вум" and "-юм" like "бисту севум",
+// "сифр", alternate spellings "чаҳор" and "панҷох", and "у" or "ва"
+// written apart. Words are not case sensitive
+// Options are accepted like english.Parse, and not used by this language
+// Returns *num2words.WordError for words that are not expected
+func Parse(str string, opts ...num2words.Option) (*big.Int, error) {
+	return parse_language.Parse(str, splitWords(str))
+}
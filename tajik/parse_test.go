@@ -0,0 +1,142 @@
+package tajik_test
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/tajik"
+)
+
+func TestParse(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, expected string) {
+		n, err := tajik.Parse(str)
+		if !is.Msg("str=%#v", str).NotErr(err) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(n.String(), expected)
+	}
+	test("сифр", "0")
+	test("Сифр", "0")
+	test("як", "1")
+	test("ду", "2")
+	test("бисту се", "23")
+	test("саду панҷ", "105")
+	test("дусаду сию панҷ ҳазор", "235000")
+	test("дусаду сӣу панҷ ҳазор", "235000")
+	test("ҳазору як", "1001")
+	test("як ҳазору як", "1001")
+	test("як миллиону дусад", "1000200")
+	test("ду миллиону сӣ панҷ ҳазор", "2035000")
+	test("ду миллион ҳазор", "2001000")
+	test("як миллион миллиард", "1000000000000000")
+	test("даҳ ҳазор миллиард миллиард", "10000000000000000000000")
+	test("Манфӣ панҷ", "-5")
+	test("манфӣ бисту ду", "-22")
+	test("2 миллион", "2000000")
+
+	// "у" and "ва" written apart
+	test("бист у се", "23")
+	test("бист ва се", "23")
+	test("сад ва бист", "120")
+	test("сию ду", "32")
+	test("си", "30")
+	test("саду сию се", "133")
+
+	// alternate spellings
+	test("чаҳор", "4")
+	test("чаҳордаҳ", "14")
+	test("чаҳорсад", "400")
+	test("панҷох", "50")
+	test("панҷоҳу ду", "52")
+	test("яксад", "100")
+	test("се сад", "300")
+	test("ДУ ҲАЗОР", "2000")
+
+	// ordinals
+	test("якум", "1")
+	test("дуюм", "2")
+	test("севум", "3")
+	test("сеюм", "3")
+	test("панҷум", "5")
+	test("ҳафтюм", "7")
+	test("даҳум", "10")
+	test("бистюм", "20")
+	test("сюм", "30")
+	test("сиюм", "30")
+	test("бисту севум", "23")
+	test("саду сюм", "130")
+	test("ҳазорюм", "1000")
+	test("як миллионюм", "1000000")
+	test("сифрюм", "0")
+}
+
+func TestParseError(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, offset int, word string) {
+		_, err := tajik.Parse(str)
+		is.Msg("str=%#v", str).True(errors.Is(err, num2words.ErrInvalidWord))
+		var wordErr *num2words.WordError
+		if !is.Msg("str=%#v", str).True(errors.As(err, &wordErr)) {
+			return
+		}
+		is.Msg("str=%#v", str).Equal(wordErr.Input, str)
+		is.Msg("str=%#v", str).Equal(wordErr.Offset, offset)
+		is.Msg("str=%#v", str).Equal(wordErr.Word, word)
+	}
+	test("себ", 0, "себ")
+	test("панҷ панҷ", len("панҷ "), "панҷ")
+	test("бисту сӣ", len("бисту "), "сӣ")
+	test("бисту ёздаҳ", len("бисту "), "ёздаҳ")
+	test("сеу ҳазор", len("сеу "), "ҳазор")
+	test("ду ҳазору се миллион", len("ду ҳазору се "), "миллион")
+	test("ҳазор ҳазор", len("ҳазор "), "ҳазор")
+	test("сифру як", len("сифру "), "як")
+	test("якум ду", 0, "якум")
+	test("Манфӣ", 0, "Манфӣ")
+	test("бисту", 0, "бисту")
+	test("бист ва", len("бист "), "ва")
+
+	_, err := tajik.Parse(" ")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	is := is.New(t).Lax()
+	rng := rand.New(rand.NewSource(1))
+	optionSets := [][]num2words.Option{
+		nil,
+		{num2words.WithConjunction(" ")},
+		{num2words.WithGroupSeparator(" ")},
+		{num2words.WithCase(num2words.CaseTitle)},
+	}
+	check := func(words string, expected *big.Int) {
+		n, err := tajik.Parse(words)
+		if !is.Msg("words=%#v", words).NotErr(err) {
+			return
+		}
+		is.Msg("words=%#v", words).Equal(n.String(), expected.String())
+	}
+	for range 300 {
+		digits := make([]byte, 1+rng.Intn(40))
+		for i := range digits {
+			digits[i] = byte('0' + rng.Intn(10))
+			if rng.Intn(2) == 0 {
+				digits[i] = '0'
+			}
+		}
+		bn, _ := new(big.Int).SetString(string(digits), 10)
+		for _, opts := range optionSets {
+			check(tajik.ConvertBigInt(bn, opts...), bn)
+			check(tajik.ConvertOrdinalBigInt(bn, opts...), bn)
+			check(tajik.ConvertBigIntSigned(new(big.Int).Neg(bn), opts...), new(big.Int).Neg(bn))
+		}
+	}
+	for _, tc := range testData {
+		check(tc.Words, tc.BigInt)
+	}
+}
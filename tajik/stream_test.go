@@ -0,0 +1,73 @@
+package tajik_test
+
+import (
+	"io"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+	"github.com/ilius/num2words/tajik"
+)
+
+func convertReader(t *testing.T, r io.Reader, opts ...num2words.Option) string {
+	t.Helper()
+	buf := &strings.Builder{}
+	err := tajik.ConvertReader(buf, r, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestConvertReader(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		is.Msg("num=%v", tc.String).Equal(
+			convertReader(t, strings.NewReader(tc.String)),
+			tc.Words,
+		)
+	}
+	is.Equal(
+		convertReader(t, struct{ io.Reader }{strings.NewReader("0001002\n")}),
+		tajik.ConvertBigInt(big.NewInt(1002)),
+	)
+}
+
+func TestConvertReaderLarge(t *testing.T) {
+	is := is.New(t).Lax()
+	str := strings.Repeat("9876543210", 300/10) + "123"
+	bn := &big.Int{}
+	bn.SetString(str, 10)
+	is.Equal(
+		convertReader(t, strings.NewReader(str)),
+		tajik.ConvertBigInt(bn),
+	)
+	is.Equal(
+		convertReader(t, strings.NewReader(str), num2words.WithCase(num2words.CaseUpper)),
+		tajik.ConvertBigInt(bn, num2words.WithCase(num2words.CaseUpper)),
+	)
+}
+
+func TestConvertReaderCase(t *testing.T) {
+	is := is.New(t).Lax()
+	rng := rand.New(rand.NewSource(1))
+	for _, c := range []num2words.Case{num2words.CaseTitle, num2words.CaseSentence} {
+		opt := num2words.WithCase(c)
+		for range 300 {
+			digits := make([]byte, 1+rng.Intn(40))
+			for i := range digits {
+				digits[i] = byte('0' + rng.Intn(10))
+			}
+			str := string(digits)
+			words, err := tajik.ConvertString(str, opt)
+			is.NotErr(err)
+			is.Msg("num=%v, case=%v", str, c).Equal(
+				convertReader(t, strings.NewReader(str), opt),
+				words,
+			)
+		}
+	}
+}
@@ -3,13 +3,16 @@ package tajik_test
 import (
 	"bufio"
 	"compress/gzip"
+	"errors"
 	"log"
+	"math"
 	"math/big"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
 	"github.com/ilius/num2words/tajik"
 )
 
@@ -114,6 +117,87 @@ func TestConvertBigIntSigned(t *testing.T) {
 	}
 }
 
+func TestConvertStringOptions(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, words string, opts ...num2words.Option) {
+		actual, err := tajik.ConvertString(str, opts...)
+		is.NotErr(err)
+		is.Msg("num=%v", str).Equal(actual, words)
+	}
+	test("1234", "ҳазору дусаду сӣу чор")
+	test("1234", "Ҳазору Дусаду Сӣу Чор", num2words.WithCase(num2words.CaseTitle))
+	test("1234", "ҲАЗОРУ ДУСАДУ СӢУ ЧОР", num2words.WithCase(num2words.CaseUpper))
+	test("1234", "ҳазор, дусаду сӣу чор", num2words.WithGroupSeparator(", "))
+}
+
+func TestConvertOrdinalOptions(t *testing.T) {
+	is := is.New(t)
+	is.Equal(
+		tajik.ConvertOrdinalBigInt(big.NewInt(3), num2words.WithCase(num2words.CaseSentence)),
+		"Севум",
+	)
+	is.Equal(
+		tajik.ConvertBigIntSigned(big.NewInt(-3), num2words.WithCase(num2words.CaseLower)),
+		"манфӣ се",
+	)
+}
+
+func TestConvertStringErrors(t *testing.T) {
+	is := is.New(t).Lax()
+	_, err := tajik.ConvertString("")
+	is.True(errors.Is(err, num2words.ErrEmpty))
+
+	_, err = tajik.ConvertString("1234x6")
+	is.True(errors.Is(err, num2words.ErrInvalidChar))
+	var charErr *num2words.InvalidCharError
+	if is.True(errors.As(err, &charErr)) {
+		is.Equal(charErr.Offset, 4)
+		is.Equal(charErr.Char, 'x')
+	}
+
+	_, err = tajik.ConvertString("12")
+	is.NotErr(err)
+}
+
+func TestConvertStringNormalize(t *testing.T) {
+	is := is.New(t).Lax()
+	test := func(str string, words string) {
+		actual, err := tajik.ConvertString(str)
+		is.Msg("num=%#v", str).NotErr(err)
+		is.Msg("num=%#v", str).Equal(actual, words)
+	}
+	test("-3", "Манфӣ се")
+	test("1 001", "ҳазору як")
+	test("0000", "сифр")
+}
+
+func TestConvertGeneric(t *testing.T) {
+	is := is.New(t).Lax()
+	for _, tc := range testData {
+		if !tc.BigInt.IsInt64() {
+			continue
+		}
+		n := tc.BigInt.Int64()
+		is.Msg("num=%v", n).Equal(tajik.Convert(n), tc.Words)
+		is.Msg("num=%v", n).Equal(tajik.Convert(uint64(n)), tc.Words)
+		bn_neg := big.NewInt(-n)
+		is.Msg("num=%v", -n).Equal(tajik.Convert(-n), tajik.ConvertBigIntSigned(bn_neg))
+	}
+	testLimit := func(n string, words string) {
+		bn := &big.Int{}
+		bn.SetString(n, 10)
+		is.Msg("num=%v", n).Equal(words, tajik.ConvertBigIntSigned(bn))
+	}
+	testLimit("-9223372036854775808", tajik.Convert(int64(math.MinInt64)))
+	testLimit("9223372036854775807", tajik.Convert(int64(math.MaxInt64)))
+	testLimit("18446744073709551615", tajik.Convert(uint64(math.MaxUint64)))
+	testLimit("-128", tajik.Convert(int8(math.MinInt8)))
+	testLimit("255", tajik.Convert(uint8(255)))
+	testLimit("-2147483648", tajik.Convert(int32(math.MinInt32)))
+	testLimit("0", tajik.Convert(0))
+	testLimit("-7", tajik.Convert(-7))
+}
+
 func Benchmark_convert_string_bigInt(b *testing.B) {
 	b.Run("string", func(b *testing.B) {
 		for _, tc := range testData {
@@ -0,0 +1,122 @@
+package num2words
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind is the type of a Token
+type TokenKind uint8
+
+const (
+	// TokenDigit: "One" to "Nine", and "Zero"
+	TokenDigit TokenKind = iota + 1
+	// TokenTeen: "Ten" to "Nineteen"
+	TokenTeen
+	// TokenTens: "Twenty", "Thirty", ...
+	TokenTens
+	// TokenHundred: "Hundred", or a hundreds word like "دویست"
+	TokenHundred
+	// TokenScale: "Thousand", "Million", "میلیارد", ...
+	TokenScale
+	// TokenConjunction: group separator or conjunction, like "," or "و"
+	TokenConjunction
+	// TokenSign: "Negative"
+	TokenSign
+	// TokenOrdinalSuffix: like "م" in "پنجم"
+	TokenOrdinalSuffix
+	// TokenDecimalPoint: "Point" in "Three Point One Four"
+	TokenDecimalPoint
+	// TokenUnit: unit of measure or money, like "Dollars" or "Cents"
+	TokenUnit
+	// TokenNumeral: a number written with digits, like "56/100"
+	TokenNumeral
+	// TokenQualifier: "Only" after amounts
+	TokenQualifier
+)
+
+var tokenKindNames = []string{
+	"",
+	"digit",
+	"teen",
+	"tens",
+	"hundred",
+	"scale",
+	"conjunction",
+	"sign",
+	"ordinal-suffix",
+	"decimal-point",
+	"unit",
+	"numeral",
+	"qualifier",
+}
+
+func (k TokenKind) String() string {
+	if int(k) < len(tokenKindNames) {
+		return tokenKindNames[k]
+	}
+	return ""
+}
+
+// Token is a word (or a punctuation) in the output of convert functions
+type Token struct {
+	Kind TokenKind
+	Text string
+
+	// Sep is written before Text, for example " " or "-"
+	// It is empty for the first token
+	Sep string
+
+	// Group is the index of 3-digit group the token belongs to (0 is the least
+	// significant group), or -1 for tokens out of groups, like TokenSign,
+	// TokenDecimalPoint, digits after decimal point and TokenUnit
+	Group int
+}
+
+// JoinTokens returns the string of tokens, which is the output of
+// convert functions
+func JoinTokens(tokens []Token) string {
+	n := 0
+	for _, t := range tokens {
+		n += len(t.Sep) + len(t.Text)
+	}
+	var sb strings.Builder
+	sb.Grow(n)
+	for _, t := range tokens {
+		sb.WriteString(t.Sep)
+		sb.WriteString(t.Text)
+	}
+	return sb.String()
+}
+
+// ApplyCaseTokens converts the letter case of tokens in-place, consistent
+// with ApplyCase on the joined string
+func (o *Options) ApplyCaseTokens(tokens []Token) {
+	switch o.Case {
+	case CaseDefault:
+		return
+	case CaseLower, CaseUpper:
+		for i := range tokens {
+			tokens[i].Text = o.ApplyCase(tokens[i].Text)
+		}
+		return
+	}
+	for i := range tokens {
+		t := &tokens[i]
+		switch {
+		case o.Case == CaseTitle:
+			t.Text = titleCase(t.Text, i == 0 || wordStart(t.Sep))
+		case i == 0:
+			t.Text = o.ApplyCase(t.Text)
+		default:
+			t.Text = strings.ToLower(t.Text)
+		}
+	}
+}
+
+// wordStart returns true if a token after sep starts a new word
+func wordStart(sep string) bool {
+	r, _ := utf8.DecodeLastRuneInString(sep)
+	return unicode.IsSpace(r) || r == '-'
+}
@@ -0,0 +1,55 @@
+package num2words_test
+
+import (
+	"testing"
+
+	"github.com/ilius/is/v2"
+	"github.com/ilius/num2words"
+)
+
+func TestJoinTokens(t *testing.T) {
+	is := is.New(t)
+	tokens := []num2words.Token{
+		{Kind: num2words.TokenDigit, Text: "Two", Group: 1},
+		{Kind: num2words.TokenScale, Text: "Thousand", Sep: " ", Group: 1},
+		{Kind: num2words.TokenConjunction, Text: ",", Group: 0},
+		{Kind: num2words.TokenTens, Text: "Twenty", Sep: " ", Group: 0},
+		{Kind: num2words.TokenDigit, Text: "One", Sep: "-", Group: 0},
+	}
+	is.Equal(num2words.JoinTokens(tokens), "Two Thousand, Twenty-One")
+	is.Equal(num2words.JoinTokens(nil), "")
+}
+
+func TestApplyCaseTokens(t *testing.T) {
+	is := is.New(t)
+	newTokens := func() []num2words.Token {
+		return []num2words.Token{
+			{Kind: num2words.TokenSign, Text: "negative", Group: -1},
+			{Kind: num2words.TokenTens, Text: "TWENTY", Sep: " ", Group: 0},
+			{Kind: num2words.TokenDigit, Text: "one", Sep: "-", Group: 0},
+		}
+	}
+	test := func(c num2words.Case, expected string) {
+		tokens := newTokens()
+		o := &num2words.Options{Case: c}
+		o.ApplyCaseTokens(tokens)
+		is.Msg("case=%v", c).Equal(num2words.JoinTokens(tokens), expected)
+		is.Msg("case=%v", c).Equal(
+			o.ApplyCase(num2words.JoinTokens(newTokens())),
+			expected,
+		)
+	}
+	test(num2words.CaseDefault, "negative TWENTY-one")
+	test(num2words.CaseLower, "negative twenty-one")
+	test(num2words.CaseUpper, "NEGATIVE TWENTY-ONE")
+	test(num2words.CaseTitle, "Negative Twenty-One")
+	test(num2words.CaseSentence, "Negative twenty-one")
+}
+
+func TestTokenKindString(t *testing.T) {
+	is := is.New(t)
+	is.Equal(num2words.TokenScale.String(), "scale")
+	is.Equal(num2words.TokenOrdinalSuffix.String(), "ordinal-suffix")
+	is.Equal(num2words.TokenKind(0).String(), "")
+	is.Equal(num2words.TokenKind(100).String(), "")
+}